@@ -24,6 +24,7 @@ const (
 type EmailAutomation struct {
 	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
 	ClientID    primitive.ObjectID `bson:"client_id" json:"client_id"`
+	AccountID   string             `bson:"account_id" json:"account_id"` // اسکوپ Tenant مانند templates.account_id
 	Name        string             `bson:"name" json:"name"`
 	Description string             `bson:"description" json:"description"`
 	Type        AutomationType     `bson:"type" json:"type"`
@@ -66,6 +67,8 @@ type AutomationStep struct {
 	Subject   string             `bson:"subject" json:"subject"`
 	FromName  string             `bson:"from_name" json:"from_name"`
 	FromEmail string             `bson:"from_email" json:"from_email"`
+	// کمپین متصل (اختیاری)؛ اگر MessageID خالی باشد محتوای کمپین ارسال می‌شود
+	CampaignID string `bson:"campaign_id,omitempty" json:"campaign_id,omitempty"`
 
 	// برای مرحله تأخیر
 	DelayTime int    `bson:"delay_time" json:"delay_time"` // مقدار به روز
@@ -79,6 +82,10 @@ type AutomationStep struct {
 	// برای مرحله تقسیم
 	SplitRatio   []float64            `bson:"split_ratio" json:"split_ratio"`
 	SplitStepIDs []primitive.ObjectID `bson:"split_step_ids" json:"split_step_ids"`
+	// آمار هر شاخه (هم‌ترتیب با SplitStepIDs)
+	SplitStats []StepStats `bson:"split_stats,omitempty" json:"split_stats,omitempty"`
+	// شاخه برنده؛ بعد از اعلام برنده همه ورودی‌های جدید به آن هدایت می‌شوند
+	SplitWinnerStepID primitive.ObjectID `bson:"split_winner_step_id,omitempty" json:"split_winner_step_id,omitempty"`
 
 	// آمار مرحله
 	Statistics StepStats `bson:"statistics" json:"statistics"`
@@ -126,6 +133,7 @@ type StepStats struct {
 type AutomationJourney struct {
 	ID                primitive.ObjectID     `bson:"_id,omitempty" json:"id"`
 	AutomationID      primitive.ObjectID     `bson:"automation_id" json:"automation_id"`
+	AccountID         string                 `bson:"account_id" json:"account_id"`
 	SubscriberID      primitive.ObjectID     `bson:"subscriber_id" json:"subscriber_id"`
 	SubscriberEmail   string                 `bson:"subscriber_email" json:"subscriber_email"`
 	CurrentStepID     primitive.ObjectID     `bson:"current_step_id" json:"current_step_id"`
@@ -152,7 +160,7 @@ type JourneyStep struct {
 	// برای مرحله‌های ایمیل
 	MessageID      primitive.ObjectID `bson:"message_id,omitempty" json:"message_id,omitempty"`
 	DeliveryID     primitive.ObjectID `bson:"delivery_id,omitempty" json:"delivery_id,omitempty"`
-	EmailStatus    string             `bson:"email_status,omitempty" json:"email_status,omitempty"` // "sent", "delivered", "opened", "clicked", "bounced"
+	EmailStatus    string             `bson:"email_status,omitempty" json:"email_status,omitempty"` // "queued" (در صف ارسال), "sent", "delivered", "opened", "clicked", "bounced"
 	EmailOpenedAt  time.Time          `bson:"email_opened_at,omitempty" json:"email_opened_at,omitempty"`
 	EmailClickedAt time.Time          `bson:"email_clicked_at,omitempty" json:"email_clicked_at,omitempty"`
 
@@ -173,3 +181,108 @@ type JourneyStep struct {
 	// اطلاعات خطا در صورت وجود
 	ErrorMessage string `bson:"error_message,omitempty" json:"error_message,omitempty"`
 }
+
+// انواع مرحله اتوماسیون
+const (
+	STEP_EMAIL     = "email"
+	STEP_DELAY     = "delay"
+	STEP_CONDITION = "condition"
+	STEP_SPLIT     = "split"
+	STEP_EXIT      = "exit"
+
+	// فقط در StepHistory: اقدام دستی پشتیبانی (Variables: action، actor، reason)
+	STEP_MANUAL = "manual"
+)
+
+// اقدام‌های دستی روی جریان
+const (
+	JOURNEY_ACTION_PAUSE    = "pause"
+	JOURNEY_ACTION_RESUME   = "resume"
+	JOURNEY_ACTION_EXIT     = "exit"
+	JOURNEY_ACTION_MOVE     = "move"
+	JOURNEY_ACTION_REENROLL = "reenroll"
+)
+
+// وضعیت‌های جریان اتوماسیون
+const (
+	JOURNEY_ACTIVE    = "active"
+	JOURNEY_COMPLETED = "completed"
+	JOURNEY_PAUSED    = "paused"
+	JOURNEY_EXITED    = "exited"
+)
+
+// وضعیت‌های مرحله طی شده
+const (
+	JOURNEY_STEP_WAITING   = "waiting" // مرحله تأخیر (یا ایمیل خارج از پنجره ارسال) که هنوز زمانش نرسیده
+	JOURNEY_STEP_COMPLETED = "completed"
+	JOURNEY_STEP_SKIPPED   = "skipped"
+	JOURNEY_STEP_FAILED    = "failed"
+)
+
+// DelayDuration مدت مرحله تأخیر بر اساس DelayUnit (پیش‌فرض روز)
+func (s *AutomationStep) DelayDuration() time.Duration {
+	d := time.Duration(s.DelayTime)
+	switch s.DelayUnit {
+	case "minutes":
+		return d * time.Minute
+	case "hours":
+		return d * time.Hour
+	case "weeks":
+		return d * 7 * 24 * time.Hour
+	default:
+		return d * 24 * time.Hour
+	}
+}
+
+// SplitBranch شماره شاخه‌ای که به stepID می‌رود (-1 اگر نباشد)
+func (s *AutomationStep) SplitBranch(stepID primitive.ObjectID) int {
+	for i, id := range s.SplitStepIDs {
+		if id == stepID {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindStep جستجوی مرحله با شناسه
+func (a *EmailAutomation) FindStep(id primitive.ObjectID) *AutomationStep {
+	for i := range a.Steps {
+		if a.Steps[i].ID == id {
+			return &a.Steps[i]
+		}
+	}
+	return nil
+}
+
+// FirstStep مرحله با کمترین StepNumber
+func (a *EmailAutomation) FirstStep() *AutomationStep {
+	var first *AutomationStep
+	for i := range a.Steps {
+		if first == nil || a.Steps[i].StepNumber < first.StepNumber {
+			first = &a.Steps[i]
+		}
+	}
+	return first
+}
+
+// NextStep مرحله بعدی در ترتیب خطی (کوچکترین StepNumber بزرگتر از مرحله فعلی)؛ nil یعنی پایان
+func (a *EmailAutomation) NextStep(current *AutomationStep) *AutomationStep {
+	var next *AutomationStep
+	for i := range a.Steps {
+		s := &a.Steps[i]
+		if s.StepNumber > current.StepNumber && (next == nil || s.StepNumber < next.StepNumber) {
+			next = s
+		}
+	}
+	return next
+}
+
+// LastEmailStep آخرین مرحله ایمیل طی شده (برای شرط‌های تعامل مثل email_opened)
+func (j *AutomationJourney) LastEmailStep() *JourneyStep {
+	for i := len(j.StepHistory) - 1; i >= 0; i-- {
+		if j.StepHistory[i].StepType == STEP_EMAIL && j.StepHistory[i].Status != JOURNEY_STEP_WAITING {
+			return &j.StepHistory[i]
+		}
+	}
+	return nil
+}
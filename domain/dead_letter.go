@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// وضعیت آیتم صف مرده
+type DeadLetterStatus string
+
+const (
+	DEAD_LETTER_DEAD     DeadLetterStatus = "dead"     // منتظر بررسی
+	DEAD_LETTER_REPLAYED DeadLetterStatus = "replayed" // دوباره به صف ارسال برگشت
+)
+
+// دسته‌بندی خطای آخرین تلاش برای گروه‌بندی و Replay انتخابی
+const (
+	ERROR_CLASS_TIMEOUT       = "timeout"       // پاسخ ارائه‌دهنده به موقع نرسید
+	ERROR_CLASS_CONNECTION    = "connection"    // ارائه‌دهنده در دسترس نبود
+	ERROR_CLASS_RATE_LIMITED  = "rate_limited"  // محدودیت نرخ ارائه‌دهنده
+	ERROR_CLASS_REJECTED      = "rejected"      // گیرنده یا محتوا توسط ارائه‌دهنده رد شد
+	ERROR_CLASS_PERMANENT     = "permanent"     // Handler خطا را دائمی اعلام کرد
+	ERROR_CLASS_LEASE_EXPIRED = "lease_expired" // Worker پیش از ثبت نتیجه از کار افتاد (آیتم مسموم)
+	ERROR_CLASS_UNKNOWN       = "unknown"
+)
+
+// DeadLetter آیتم صفی که بعد از MaxRetries (یا خطای دائمی) شکست خورده است
+type DeadLetter struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	AccountID      string             `bson:"account_id" json:"account_id"`
+	CampaignID     primitive.ObjectID `bson:"campaign_id" json:"campaign_id"`
+	BatchID        primitive.ObjectID `bson:"batch_id" json:"batch_id"`
+	ItemID         primitive.ObjectID `bson:"item_id" json:"item_id"`
+	RecipientEmail string             `bson:"recipient_email" json:"recipient_email"`
+	ErrorClass     string             `bson:"error_class" json:"error_class"`
+	LastError      string             `bson:"last_error" json:"last_error"`
+	Attempts       int                `bson:"attempts" json:"attempts"`
+	// Payload آیتم صف در لحظه شکست؛ تاریخچه خطاها در Payload.ErrorHistory است
+	Payload       QueueItem          `bson:"payload" json:"payload"`
+	Status        DeadLetterStatus   `bson:"status" json:"status"`
+	DeadAt        time.Time          `bson:"dead_at" json:"dead_at"`
+	ReplayedAt    time.Time          `bson:"replayed_at" json:"replayed_at"`
+	ReplayBatchID primitive.ObjectID `bson:"replay_batch_id" json:"replay_batch_id"`
+	ReplayItemID  primitive.ObjectID `bson:"replay_item_id" json:"replay_item_id"`
+}
@@ -9,4 +9,5 @@ type DomainStat struct {
 	ClickRate      float64 `bson:"click_rate" json:"clickRate"`
 	BounceRate     float64 `bson:"bounce_rate" json:"bounceRate"`
 	SpamReportRate float64 `bson:"spam_report_rate" json:"spamReportRate"`
+	DeferralRate   float64 `bson:"deferral_rate" json:"deferralRate"` // نسبت تلاش‌هایی که با خطای موقت به تعویق افتادند
 }
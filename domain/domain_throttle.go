@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// DomainThrottleRule - محدودیت نرخ ارسال به دامنه گیرنده
+// قانون خاص‌تر اولویت دارد: اکانت و دامنه فرستنده، اکانت، پیش‌فرض سراسری با دامنه فرستنده، پیش‌فرض سراسری
+type DomainThrottleRule struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	AccountID       string             `bson:"account_id" json:"account_id"`             // خالی یعنی پیش‌فرض همه اکانت‌ها
+	SendingDomain   string             `bson:"sending_domain" json:"sending_domain"`     // خالی یعنی همه دامنه‌های فرستنده
+	RecipientDomain string             `bson:"recipient_domain" json:"recipient_domain"` // مثل gmail.com (نام‌های دیگر همان ISP هم شامل می‌شوند)
+	PerMinute       int                `bson:"per_minute" json:"per_minute"`
+	Burst           int                `bson:"burst" json:"burst"` // حداکثر ارسال پشت سر هم (ظرفیت Token Bucket)
+	CreatedAt       time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt       time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// TokenBucket وضعیت مشترک یک Bucket بین همه نمونه‌های سرویس (domain_throttle_buckets)
+type TokenBucket struct {
+	Key       string    `bson:"bucket_key" json:"bucket_key"` // اکانت|دامنه فرستنده|دامنه گیرنده
+	Tokens    float64   `bson:"tokens" json:"tokens"`
+	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+// Take یک توکن برمی‌دارد یا مدت انتظار تا توکن بعدی را برمی‌گرداند (rate توکن در ثانیه)
+func (b *TokenBucket) Take(now time.Time, rate, burst float64) time.Duration {
+	if elapsed := now.Sub(b.UpdatedAt).Seconds(); elapsed > 0 {
+		b.Tokens = math.Min(burst, b.Tokens+elapsed*rate)
+		b.UpdatedAt = now
+	}
+	if b.Tokens >= 1 {
+		b.Tokens--
+		return 0
+	}
+	return time.Duration((1 - b.Tokens) / rate * float64(time.Second))
+}
+
+// DomainEventCount شمارنده رویدادهای تحویل یک دامنه گیرنده در یک بازه (domain_event_stats)
+type DomainEventCount struct {
+	EventID         string    `bson:"event_id,omitempty" json:"event_id,omitempty"` // شمارش یک رویداد که فقط یک بار ثبت می‌شود
+	AccountID       string    `bson:"account_id" json:"account_id"`
+	RecipientDomain string    `bson:"recipient_domain" json:"recipient_domain"` // دامنه اصلی ISP
+	WindowStart     time.Time `bson:"window_start" json:"window_start"`
+	Sent            int       `bson:"sent" json:"sent"`
+	Delivered       int       `bson:"delivered" json:"delivered"`
+	Bounced         int       `bson:"bounced" json:"bounced"`   // Hard Bounce
+	Deferred        int       `bson:"deferred" json:"deferred"` // Soft Bounce (تعویق توسط ISP)
+}
@@ -9,6 +9,7 @@ import (
 type Message struct {
 	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
 	ClientID   primitive.ObjectID `bson:"client_id" json:"client_id"`
+	AccountID  string             `bson:"account_id" json:"account_id"` // اسکوپ Tenant مانند templates.account_id
 	Name       string             `bson:"name" json:"name"`
 	Subject    string             `bson:"subject" json:"subject"`
 	PreHeader  string             `bson:"pre_header" json:"pre_header"`
@@ -39,9 +40,35 @@ type Message struct {
 type MessageFolder struct {
 	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
 	ClientID    primitive.ObjectID `bson:"client_id" json:"client_id"`
+	AccountID   string             `bson:"account_id" json:"account_id"` // اسکوپ Tenant مانند templates.account_id
 	Name        string             `bson:"name" json:"name"`
 	Description string             `bson:"description" json:"description"`
 	ParentID    primitive.ObjectID `bson:"parent_id" json:"parent_id"`
 	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
 	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
 }
+
+// درخت پوشه‌ها (برای نمایش ساختار پوشه‌های یک اکانت)
+type MessageFolderNode struct {
+	Folder   MessageFolder        `json:"folder"`
+	Children []*MessageFolderNode `json:"children"`
+}
+
+// BuildFolderTree لیست مسطح پوشه‌ها را به درخت تبدیل می‌کند (پوشه‌های بدون والد ریشه هستند)
+func BuildFolderTree(folders []MessageFolder) []*MessageFolderNode {
+	nodes := make(map[primitive.ObjectID]*MessageFolderNode, len(folders))
+	for _, f := range folders {
+		nodes[f.ID] = &MessageFolderNode{Folder: f}
+	}
+
+	var roots []*MessageFolderNode
+	for _, f := range folders {
+		node := nodes[f.ID]
+		if parent, ok := nodes[f.ParentID]; ok && !f.ParentID.IsZero() {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+	return roots
+}
@@ -30,10 +30,14 @@ const (
 // آیتم صف پیام
 type QueueItem struct {
 	ID             primitive.ObjectID     `bson:"_id,omitempty" json:"id"`
+	BatchID        primitive.ObjectID     `bson:"batch_id" json:"batch_id"`
+	AccountID      string                 `bson:"account_id" json:"account_id"`
 	CampaignID     primitive.ObjectID     `bson:"campaign_id" json:"campaign_id"`
+	CampaignRef    string                 `bson:"campaign_ref" json:"campaign_ref"` // شناسه کمپین‌های MTA (UUID) که در CampaignID جا نمی‌شود
 	MessageID      primitive.ObjectID     `bson:"message_id" json:"message_id"`
 	RecipientEmail string                 `bson:"recipient_email" json:"recipient_email"`
 	RecipientData  map[string]interface{} `bson:"recipient_data" json:"recipient_data"`
+	SendingDomain  string                 `bson:"sending_domain" json:"sending_domain"` // دامنه فرستنده برای محدودیت نرخ به تفکیک دامنه
 	Status         QueueStatus            `bson:"status" json:"status"`
 	Priority       QueuePriority          `bson:"priority" json:"priority"`
 	CreatedAt      time.Time              `bson:"created_at" json:"created_at"`
@@ -43,6 +47,7 @@ type QueueItem struct {
 	RetryCount     int                    `bson:"retry_count" json:"retry_count"`
 	MaxRetries     int                    `bson:"max_retries" json:"max_retries"`
 	LastError      string                 `bson:"last_error" json:"last_error"`
+	ErrorHistory   []QueueAttempt         `bson:"error_history" json:"error_history"` // خطای هر تلاش ناموفق (شامل تلاش‌های قبل از Replay)
 	ExternalID     string                 `bson:"external_id" json:"external_id"`
 
 	// اطلاعات RabbitMQ
@@ -55,6 +60,7 @@ type QueueItem struct {
 // مدیر پردازش صف
 type QueueBatch struct {
 	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	AccountID      string             `bson:"account_id" json:"account_id"`
 	CampaignID     primitive.ObjectID `bson:"campaign_id" json:"campaign_id"`
 	Status         QueueStatus        `bson:"status" json:"status"`
 	ItemCount      int                `bson:"item_count" json:"item_count"`
@@ -66,3 +72,11 @@ type QueueBatch struct {
 	CompletedAt    time.Time          `bson:"completed_at" json:"completed_at"`
 	WorkerID       string             `bson:"worker_id" json:"worker_id"`
 }
+
+// QueueAttempt یک تلاش ناموفق ارسال آیتم صف
+type QueueAttempt struct {
+	Attempt  int       `bson:"attempt" json:"attempt"`
+	Error    string    `bson:"error" json:"error"`
+	WorkerID string    `bson:"worker_id" json:"worker_id"`
+	At       time.Time `bson:"at" json:"at"`
+}
@@ -0,0 +1,4 @@
+-- migrations/campaign/000003_template_processing.up.sql
+
+-- هشدارهای پایپ‌لاین پردازش نسخه (مثلاً عبور از حد Clip جیمیل)
+ALTER TABLE template_versions ADD COLUMN IF NOT EXISTS warnings TEXT[] DEFAULT '{}';
@@ -0,0 +1,4 @@
+-- migrations/campaign/000004_template_lint.up.sql
+
+-- آخرین نتیجه LintTemplate برای هر نسخه (برای بررسی پیش از ارسال کمپین)
+ALTER TABLE template_versions ADD COLUMN IF NOT EXISTS lint_result JSONB;
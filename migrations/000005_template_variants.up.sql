@@ -0,0 +1,24 @@
+-- migrations/campaign/000005_template_variants.up.sql
+
+-- زبان پیش‌فرض قالب (انتهای زنجیره Fallback و زبان current_version_id)
+ALTER TABLE templates ADD COLUMN IF NOT EXISTS default_language VARCHAR(10) NOT NULL DEFAULT 'en';
+
+-- نسخه فعلی به ازای هر زبان (fa-IR, fa, en, ...)
+CREATE TABLE IF NOT EXISTS template_current_versions (
+    template_id UUID NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+    language VARCHAR(10) NOT NULL,
+    version_id UUID NOT NULL REFERENCES template_versions(id) ON DELETE CASCADE,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (template_id, language)
+);
+
+-- انتقال نسخه‌های فعلی موجود به جدول جدید
+INSERT INTO template_current_versions (template_id, language, version_id)
+SELECT t.id, COALESCE(NULLIF(tv.language, ''), 'en'), t.current_version_id
+FROM templates t
+JOIN template_versions tv ON tv.id = t.current_version_id
+ON CONFLICT (template_id, language) DO NOTHING;
+
+UPDATE templates t SET default_language = COALESCE(NULLIF(tv.language, ''), 'en')
+FROM template_versions tv
+WHERE tv.id = t.current_version_id;
@@ -0,0 +1,42 @@
+-- migrations/campaign/000006_message_folders.up.sql
+
+-- پوشه‌ها (domain.MessageFolder)؛ شناسه‌ها ObjectID به صورت hex هستند
+CREATE TABLE IF NOT EXISTS message_folders (
+    id VARCHAR(24) PRIMARY KEY,
+    account_id VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT DEFAULT '',
+    parent_id VARCHAR(24) REFERENCES message_folders(id) ON DELETE CASCADE, -- NULL یعنی ریشه
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_message_folders_account ON message_folders(account_id);
+CREATE INDEX IF NOT EXISTS idx_message_folders_parent ON message_folders(parent_id);
+
+-- پیام‌ها (domain.Message)
+CREATE TABLE IF NOT EXISTS messages (
+    id VARCHAR(24) PRIMARY KEY,
+    account_id VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) DEFAULT '',
+    pre_header VARCHAR(255) DEFAULT '',
+    body_html TEXT DEFAULT '',
+    body_text TEXT DEFAULT '',
+    template_id VARCHAR(64),
+    is_template BOOLEAN DEFAULT FALSE,
+    language VARCHAR(10) DEFAULT 'en',
+    folder_id VARCHAR(24) REFERENCES message_folders(id) ON DELETE SET NULL,
+    variables JSONB DEFAULT '{}',
+    metadata JSONB DEFAULT '{}',
+    created_by VARCHAR(24),
+    updated_by VARCHAR(24),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_messages_account_folder ON messages(account_id, folder_id);
+
+-- قالب‌ها هم داخل پوشه قرار می‌گیرند
+ALTER TABLE templates ADD COLUMN IF NOT EXISTS folder_id VARCHAR(24) REFERENCES message_folders(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_templates_account_folder ON templates(account_id, folder_id);
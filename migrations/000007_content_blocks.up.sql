@@ -0,0 +1,41 @@
+-- migrations/campaign/000007_content_blocks.up.sql
+
+-- بلاک‌های محتوای قابل استفاده مجدد (هدر، فوتر، متن حقوقی)
+CREATE TABLE IF NOT EXISTS content_blocks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    tag VARCHAR(100) NOT NULL,
+    current_version_id UUID,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (account_id, tag)
+);
+
+CREATE TABLE IF NOT EXISTS content_block_versions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    block_id UUID NOT NULL REFERENCES content_blocks(id) ON DELETE CASCADE,
+    version_label VARCHAR(100),
+    html_content TEXT NOT NULL DEFAULT '',
+    plain_text TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- ارجاع نسخه‌های قالب به بلاک‌ها (برای Where Used)
+ALTER TABLE template_versions ADD COLUMN IF NOT EXISTS block_tags TEXT[] NOT NULL DEFAULT '{}';
+CREATE INDEX IF NOT EXISTS idx_template_versions_block_tags ON template_versions USING GIN (block_tags);
+
+-- محتوای منجمد قالب برای هر کمپین (بلاک‌ها باز شده‌اند)
+CREATE TABLE IF NOT EXISTS template_snapshots (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    template_id UUID NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+    version_id UUID NOT NULL REFERENCES template_versions(id) ON DELETE CASCADE,
+    campaign_id VARCHAR(64) NOT NULL,
+    language VARCHAR(10) NOT NULL,
+    subject TEXT,
+    html_content TEXT NOT NULL,
+    plain_text TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_template_snapshots_campaign ON template_snapshots (campaign_id);
@@ -0,0 +1,28 @@
+-- migrations/campaign/000008_template_approval.up.sql
+
+-- بازبین‌های نام‌برده قالب؛ آرایه خالی یعنی نسخه‌ها بدون بازبینی تایید می‌شوند
+ALTER TABLE templates ADD COLUMN IF NOT EXISTS reviewers TEXT[] NOT NULL DEFAULT '{}';
+
+-- وضعیت بازبینی نسخه (نسخه‌های موجود تایید شده محسوب می‌شوند)
+ALTER TABLE template_versions ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'approved';
+ALTER TABLE template_versions ADD COLUMN IF NOT EXISTS created_by VARCHAR(64);
+
+CREATE TABLE IF NOT EXISTS template_reviews (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    template_id UUID NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+    version_id UUID NOT NULL REFERENCES template_versions(id) ON DELETE CASCADE,
+    reviewer_id VARCHAR(64) NOT NULL,
+    decision VARCHAR(20) NOT NULL,
+    comment TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_template_reviews_version ON template_reviews (version_id);
+
+-- قفل ویرایش هر قالب (یک ردیف به ازای قالب؛ قفل منقضی شده قابل بازنویسی است)
+CREATE TABLE IF NOT EXISTS template_locks (
+    template_id UUID PRIMARY KEY REFERENCES templates(id) ON DELETE CASCADE,
+    locked_by VARCHAR(64) NOT NULL,
+    locked_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
@@ -0,0 +1,36 @@
+-- migrations/campaign/000009_automation_journeys.up.sql
+
+-- جریان هر مشترک در اتوماسیون (domain.AutomationJourney)؛ شناسه‌ها ObjectID به صورت hex هستند
+CREATE TABLE IF NOT EXISTS automation_journeys (
+    id VARCHAR(24) PRIMARY KEY,
+    automation_id VARCHAR(24) NOT NULL,
+    account_id VARCHAR(64) NOT NULL,
+    subscriber_id VARCHAR(24),
+    subscriber_email VARCHAR(255) NOT NULL,
+    current_step_id VARCHAR(24),
+    current_step_number INT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    entered_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE,
+    next_step_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    step_history JSONB NOT NULL DEFAULT '[]',
+    variables JSONB NOT NULL DEFAULT '{}',
+
+    -- Lease پردازش: Worker فعلی و زمان انقضا (برای اجرای همزمان چند نمونه و بازیابی بعد از Restart)
+    locked_by VARCHAR(128),
+    locked_until TIMESTAMP WITH TIME ZONE
+);
+
+-- انتخاب جریان‌های سررسید توسط Worker ها
+CREATE INDEX IF NOT EXISTS idx_automation_journeys_due ON automation_journeys (next_step_at) WHERE status = 'active';
+CREATE INDEX IF NOT EXISTS idx_automation_journeys_automation ON automation_journeys (automation_id, subscriber_email);
+
+-- باز شدن/کلیک ایمیل‌های هر مرحله (جدا از جریان تا با Lease تداخل نداشته باشد)
+CREATE TABLE IF NOT EXISTS automation_journey_events (
+    journey_id VARCHAR(24) NOT NULL REFERENCES automation_journeys(id) ON DELETE CASCADE,
+    step_id VARCHAR(24) NOT NULL,
+    event_type VARCHAR(20) NOT NULL,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (journey_id, step_id, event_type)
+);
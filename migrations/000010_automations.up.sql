@@ -0,0 +1,47 @@
+-- migrations/campaign/000010_automations.up.sql
+
+-- تعریف اتوماسیون‌ها (domain.EmailAutomation)؛ شناسه‌ها ObjectID به صورت hex هستند
+CREATE TABLE IF NOT EXISTS email_automations (
+    id VARCHAR(24) PRIMARY KEY,
+    account_id VARCHAR(64) NOT NULL,
+    client_id VARCHAR(24),
+    name VARCHAR(255) NOT NULL,
+    description TEXT DEFAULT '',
+    type VARCHAR(50) NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT FALSE,
+    one_time_only BOOLEAN NOT NULL DEFAULT FALSE,
+    trigger_events JSONB NOT NULL DEFAULT '[]',
+    settings JSONB NOT NULL DEFAULT '{}',
+    statistics JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_automations_account ON email_automations (account_id);
+-- جستجوی اتوماسیون‌های فعال بر اساس event_type تریگر (trigger_events @> ...)
+CREATE INDEX IF NOT EXISTS idx_email_automations_triggers ON email_automations USING GIN (trigger_events) WHERE is_active;
+
+-- مراحل اتوماسیون (domain.AutomationStep)
+CREATE TABLE IF NOT EXISTS automation_steps (
+    id VARCHAR(24) PRIMARY KEY,
+    automation_id VARCHAR(24) NOT NULL REFERENCES email_automations(id) ON DELETE CASCADE,
+    step_number INT NOT NULL,
+    step_type VARCHAR(20) NOT NULL,
+    name VARCHAR(255) DEFAULT '',
+    message_id VARCHAR(24),
+    campaign_id VARCHAR(64) DEFAULT '',
+    subject VARCHAR(255) DEFAULT '',
+    from_name VARCHAR(255) DEFAULT '',
+    from_email VARCHAR(255) DEFAULT '',
+    delay_time INT NOT NULL DEFAULT 0,
+    delay_unit VARCHAR(10) DEFAULT '',
+    conditions JSONB NOT NULL DEFAULT '[]',
+    positive_step_id VARCHAR(24),
+    negative_step_id VARCHAR(24),
+    split_ratio DOUBLE PRECISION[] DEFAULT '{}',
+    split_step_ids TEXT[] DEFAULT '{}',
+    statistics JSONB NOT NULL DEFAULT '{}',
+    UNIQUE (automation_id, step_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_automation_steps_campaign ON automation_steps (campaign_id) WHERE campaign_id <> '';
@@ -0,0 +1,6 @@
+-- migrations/campaign/000011_automation_split_stats.up.sql
+
+-- آمار هر شاخه مرحله تقسیم (هم‌ترتیب با split_step_ids) و شاخه برنده
+ALTER TABLE automation_steps
+    ADD COLUMN IF NOT EXISTS split_stats JSONB NOT NULL DEFAULT '[]',
+    ADD COLUMN IF NOT EXISTS split_winner_step_id VARCHAR(24);
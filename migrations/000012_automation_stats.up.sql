@@ -0,0 +1,34 @@
+-- migrations/campaign/000012_automation_stats.up.sql
+
+-- شمارنده‌های روزانه (UTC) اتوماسیون‌ها؛ step_id خالی ردیف سطح اتوماسیون است
+CREATE TABLE IF NOT EXISTS automation_stats_daily (
+    automation_id VARCHAR(24) NOT NULL REFERENCES email_automations(id) ON DELETE CASCADE,
+    step_id VARCHAR(24) NOT NULL DEFAULT '',
+    day DATE NOT NULL,
+    entries INT NOT NULL DEFAULT 0,
+    completions INT NOT NULL DEFAULT 0,
+    drop_offs INT NOT NULL DEFAULT 0,
+    exited INT NOT NULL DEFAULT 0,
+    emails_sent INT NOT NULL DEFAULT 0,
+    emails_opened INT NOT NULL DEFAULT 0,
+    emails_clicked INT NOT NULL DEFAULT 0,
+    conversions INT NOT NULL DEFAULT 0,
+    revenue NUMERIC(14, 2) NOT NULL DEFAULT 0,
+    PRIMARY KEY (automation_id, step_id, day)
+);
+
+-- سفارش‌هایی که درآمدشان به اتوماسیون نسبت داده شده (هر سفارش یکبار)
+CREATE TABLE IF NOT EXISTS automation_conversions (
+    account_id VARCHAR(64) NOT NULL,
+    order_id VARCHAR(255) NOT NULL,
+    automation_id VARCHAR(24) NOT NULL,
+    journey_id VARCHAR(24) NOT NULL,
+    step_id VARCHAR(24) NOT NULL DEFAULT '',
+    email VARCHAR(255) NOT NULL,
+    revenue NUMERIC(14, 2) NOT NULL,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (account_id, order_id)
+);
+
+-- جستجوی جریان‌های یک مشترک برای نسبت دادن درآمد
+CREATE INDEX IF NOT EXISTS idx_automation_journeys_account_email ON automation_journeys (account_id, subscriber_email, last_updated_at);
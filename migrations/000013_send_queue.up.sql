@@ -0,0 +1,53 @@
+-- migrations/campaign/000013_send_queue.up.sql
+
+-- دسته‌های صف ارسال (domain.QueueBatch)
+CREATE TABLE IF NOT EXISTS send_queue_batches (
+    id VARCHAR(24) PRIMARY KEY,
+    account_id VARCHAR(64) NOT NULL,
+    campaign_id VARCHAR(24),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    item_count INT NOT NULL DEFAULT 0,
+    processed_count INT NOT NULL DEFAULT 0,
+    success_count INT NOT NULL DEFAULT 0,
+    failed_count INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    started_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    worker_id VARCHAR(128) DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_send_queue_batches_campaign ON send_queue_batches (account_id, campaign_id);
+
+-- آیتم‌های صف ارسال (domain.QueueItem)
+CREATE TABLE IF NOT EXISTS send_queue_items (
+    id VARCHAR(24) PRIMARY KEY,
+    batch_id VARCHAR(24) NOT NULL REFERENCES send_queue_batches(id) ON DELETE CASCADE,
+    account_id VARCHAR(64) NOT NULL,
+    campaign_id VARCHAR(24),
+    message_id VARCHAR(24),
+    recipient_email VARCHAR(255) NOT NULL,
+    recipient_data JSONB NOT NULL DEFAULT '{}',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    priority INT NOT NULL DEFAULT 5,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    scheduled_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    processed_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    retry_count INT NOT NULL DEFAULT 0,
+    max_retries INT NOT NULL DEFAULT 0,
+    last_error TEXT DEFAULT '',
+    external_id VARCHAR(255) DEFAULT '',
+    queue_name VARCHAR(255) DEFAULT '',
+    exchange VARCHAR(255) DEFAULT '',
+    routing_key VARCHAR(255) DEFAULT '',
+    server VARCHAR(255) DEFAULT '',
+
+    -- Lease (Visibility Timeout): Worker فعلی و زمان انقضا
+    locked_by VARCHAR(128),
+    locked_until TIMESTAMP WITH TIME ZONE
+);
+
+-- برداشت آیتم‌های آماده به ترتیب اولویت
+CREATE INDEX IF NOT EXISTS idx_send_queue_items_ready ON send_queue_items (priority DESC, scheduled_at)
+    WHERE status IN ('pending', 'processing');
+CREATE INDEX IF NOT EXISTS idx_send_queue_items_batch ON send_queue_items (batch_id);
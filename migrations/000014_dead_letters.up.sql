@@ -0,0 +1,27 @@
+-- migrations/campaign/000014_dead_letters.up.sql
+
+-- تاریخچه خطای هر تلاش ناموفق آیتم صف (domain.QueueAttempt)
+ALTER TABLE send_queue_items ADD COLUMN IF NOT EXISTS error_history JSONB NOT NULL DEFAULT '[]';
+
+-- صف مرده: آیتم‌هایی که بعد از MaxRetries یا با خطای دائمی شکست خورده‌اند (domain.DeadLetter)
+-- کلید خارجی به جدول‌های صف ندارد تا با پاکسازی دسته‌های قدیمی از بین نرود
+CREATE TABLE IF NOT EXISTS dead_letters (
+    id VARCHAR(24) PRIMARY KEY,
+    account_id VARCHAR(64) NOT NULL,
+    campaign_id VARCHAR(24),
+    batch_id VARCHAR(24) NOT NULL,
+    item_id VARCHAR(24) NOT NULL,
+    recipient_email VARCHAR(255) NOT NULL,
+    error_class VARCHAR(32) NOT NULL DEFAULT 'unknown',
+    last_error TEXT DEFAULT '',
+    attempts INT NOT NULL DEFAULT 0,
+    payload JSONB NOT NULL, -- ردیف کامل send_queue_items در لحظه شکست
+    status VARCHAR(20) NOT NULL DEFAULT 'dead',
+    dead_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    replayed_at TIMESTAMP WITH TIME ZONE,
+    replay_batch_id VARCHAR(24),
+    replay_item_id VARCHAR(24)
+);
+
+CREATE INDEX IF NOT EXISTS idx_dead_letters_campaign ON dead_letters (account_id, campaign_id, dead_at DESC);
+CREATE INDEX IF NOT EXISTS idx_dead_letters_class ON dead_letters (account_id, error_class, status);
@@ -0,0 +1,20 @@
+-- migrations/campaign/000015_domain_throttle.up.sql
+
+-- دامنه فرستنده آیتم صف برای محدودیت نرخ به تفکیک (دامنه فرستنده، دامنه گیرنده)
+ALTER TABLE send_queue_items ADD COLUMN IF NOT EXISTS sending_domain VARCHAR(255) NOT NULL DEFAULT '';
+
+-- آمار اخیر ارسال به تفکیک دامنه گیرنده (RecentDomainStats)
+CREATE INDEX IF NOT EXISTS idx_send_queue_items_account_processed ON send_queue_items (account_id, processed_at);
+
+-- قوانین محدودیت نرخ (domain.DomainThrottleRule)؛ account_id و sending_domain خالی یعنی پیش‌فرض
+CREATE TABLE IF NOT EXISTS domain_throttle_rules (
+    id VARCHAR(24) PRIMARY KEY,
+    account_id VARCHAR(64) NOT NULL DEFAULT '',
+    sending_domain VARCHAR(255) NOT NULL DEFAULT '',
+    recipient_domain VARCHAR(255) NOT NULL,
+    per_minute INT NOT NULL,
+    burst INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (account_id, sending_domain, recipient_domain)
+);
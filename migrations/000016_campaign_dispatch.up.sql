@@ -0,0 +1,10 @@
+-- migrations/campaign/000016_campaign_dispatch.up.sql
+
+-- شناسه کمپین‌های MTA (UUID) روی آیتم‌های صف؛ campaign_id فقط ObjectID می‌پذیرد
+ALTER TABLE send_queue_items ADD COLUMN IF NOT EXISTS campaign_ref VARCHAR(64) NOT NULL DEFAULT '';
+
+-- بررسی پایان ارسال کمپین (PendingItems)
+CREATE INDEX IF NOT EXISTS idx_send_queue_items_campaign_ref ON send_queue_items (campaign_ref, status) WHERE campaign_ref <> '';
+
+-- برداشتن کمپین‌های زمان‌بندی شده (ClaimDue)
+CREATE INDEX IF NOT EXISTS idx_campaigns_due ON campaigns (scheduled_for) WHERE status = 'scheduled';
@@ -0,0 +1,23 @@
+-- migrations/campaign/000017_suppressions.up.sql
+
+-- Bounce ها و Complaint های سراسری (مستقل از اکانت)؛ پیش از ارسال همراه فهرست Suppression اکانت بررسی می‌شوند
+CREATE TABLE IF NOT EXISTS global_suppressions (
+    email VARCHAR(320) PRIMARY KEY,
+    reason VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_global_suppressions_created ON global_suppressions (created_at);
+
+-- گیرندگانی که به دلیل Suppression برای کمپین ارسال نشدند (queueRepository.SkipItem)
+-- campaign_id شناسه کمپین MTA (campaign_ref) یا ObjectID کمپین آیتم صف است
+CREATE TABLE IF NOT EXISTS campaign_suppressions (
+    item_id VARCHAR(24) PRIMARY KEY REFERENCES send_queue_items(id) ON DELETE CASCADE,
+    account_id VARCHAR(64) NOT NULL,
+    campaign_id VARCHAR(64) NOT NULL DEFAULT '',
+    recipient_email VARCHAR(320) NOT NULL,
+    reason VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_campaign_suppressions_campaign ON campaign_suppressions (account_id, campaign_id);
@@ -0,0 +1,23 @@
+-- migrations/campaign/000018_delivery_events.up.sql
+
+-- Soft Bounce های پیاپی هر گیرنده؛ با رسیدن به حد مجاز در بازه زمانی گیرنده Suppress می‌شود
+-- تحویل موفق ردیف را حذف می‌کند
+CREATE TABLE IF NOT EXISTS soft_bounces (
+    account_id VARCHAR(64) NOT NULL,
+    email VARCHAR(320) NOT NULL,
+    count INT NOT NULL DEFAULT 1,
+    first_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (account_id, email)
+);
+
+-- رویدادهای هر گیرنده در کمپین MTA (یک ردیف برای هر نوع رویداد)؛ شمارنده‌های یکتای campaigns.stats از آن به دست می‌آیند
+CREATE TABLE IF NOT EXISTS campaign_recipient_events (
+    campaign_id UUID NOT NULL REFERENCES campaigns(id) ON DELETE CASCADE,
+    event_type VARCHAR(32) NOT NULL,
+    email VARCHAR(320) NOT NULL,
+    count BIGINT NOT NULL DEFAULT 1,
+    first_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (campaign_id, event_type, email)
+);
@@ -0,0 +1,17 @@
+-- migrations/campaign/000019_campaign_unsubscribes.up.sql
+
+-- لغو عضویت‌های ثبت شده از سرآیند List-Unsubscribe (one_click) یا صفحه لغو عضویت (link)
+-- list_id لیستی است که گیرنده از آن وارد کمپین شد (خالی برای سگمنت‌ها)
+CREATE TABLE IF NOT EXISTS campaign_unsubscribes (
+    account_id VARCHAR(64) NOT NULL,
+    campaign_id VARCHAR(64) NOT NULL,
+    list_id VARCHAR(64) NOT NULL DEFAULT '',
+    email VARCHAR(320) NOT NULL,
+    source VARCHAR(16) NOT NULL,
+    ip_address VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (account_id, campaign_id, email)
+);
+
+CREATE INDEX IF NOT EXISTS idx_campaign_unsubscribes_list ON campaign_unsubscribes (account_id, list_id);
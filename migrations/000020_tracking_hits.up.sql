@@ -0,0 +1,19 @@
+-- migrations/campaign/000020_tracking_hits.up.sql
+
+-- همه درخواست‌های پیکسل باز کردن و لینک‌های رهگیری با IP و User-Agent
+-- ردیف‌های machine (Apple MPP، اسکنرهای امنیتی) در آمار کمپین شمرده نمی‌شوند و فقط برای بررسی نگه داشته می‌شوند
+CREATE TABLE IF NOT EXISTS tracking_hits (
+    id BIGSERIAL PRIMARY KEY,
+    account_id VARCHAR(64) NOT NULL,
+    campaign_id VARCHAR(64) NOT NULL DEFAULT '',
+    email VARCHAR(320) NOT NULL,
+    event_type VARCHAR(16) NOT NULL,
+    url TEXT NOT NULL DEFAULT '',
+    ip_address VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    machine BOOLEAN NOT NULL DEFAULT FALSE,
+    machine_reason VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_tracking_hits_campaign ON tracking_hits (account_id, campaign_id, event_type, created_at);
@@ -0,0 +1,7 @@
+-- migrations/campaign/000021_template_snapshot_variants.up.sql
+
+-- اسنپ‌شات هنگام dispatch برای همه زبان‌های قالب گرفته می‌شود؛ is_default نسخه زبان پیش‌فرض قالب در همان لحظه است
+ALTER TABLE template_snapshots ADD COLUMN IF NOT EXISTS is_default BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- هر زبان قالب یک بار برای هر کمپین فریز می‌شود
+CREATE UNIQUE INDEX IF NOT EXISTS uq_template_snapshots_campaign_language ON template_snapshots (campaign_id, template_id, language);
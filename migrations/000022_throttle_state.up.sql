@@ -0,0 +1,24 @@
+-- migrations/campaign/000022_throttle_state.up.sql
+
+-- وضعیت مشترک Token Bucket ها بین همه نمونه‌های سرویس (کلید: اکانت|دامنه فرستنده|دامنه گیرنده)
+CREATE TABLE IF NOT EXISTS domain_throttle_buckets (
+    bucket_key VARCHAR(600) PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_domain_throttle_buckets_updated ON domain_throttle_buckets (updated_at);
+
+-- شمارنده رویدادهای تحویل به تفکیک دامنه گیرنده در بازه‌های ۵ دقیقه‌ای؛ مبنای کاهش خودکار نرخ
+CREATE TABLE IF NOT EXISTS domain_event_stats (
+    account_id VARCHAR(64) NOT NULL,
+    recipient_domain VARCHAR(255) NOT NULL,
+    window_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    sent BIGINT NOT NULL DEFAULT 0,
+    delivered BIGINT NOT NULL DEFAULT 0,
+    bounced BIGINT NOT NULL DEFAULT 0,
+    deferred BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (account_id, recipient_domain, window_start)
+);
+
+CREATE INDEX IF NOT EXISTS idx_domain_event_stats_window ON domain_event_stats (window_start);
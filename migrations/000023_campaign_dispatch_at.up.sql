@@ -0,0 +1,8 @@
+-- migrations/campaign/000023_campaign_dispatch_at.up.sql
+
+-- زمان برداشتن کمپین توسط dispatcher (Campaign.DispatchAt)؛ در حالت local_time زودتر از scheduled_for
+ALTER TABLE campaigns ADD COLUMN IF NOT EXISTS dispatch_at TIMESTAMP WITH TIME ZONE;
+UPDATE campaigns SET dispatch_at = scheduled_for WHERE dispatch_at IS NULL;
+
+DROP INDEX IF EXISTS idx_campaigns_due;
+CREATE INDEX IF NOT EXISTS idx_campaigns_due ON campaigns (dispatch_at) WHERE status = 'scheduled';
@@ -0,0 +1,11 @@
+-- migrations/campaign/000024_processed_email_events.up.sql
+
+-- شناسه رویدادهای تحویل که در هر شمارنده (scope) ثبت شده‌اند؛ پیام تکراری یا بازگشته به صف دوباره شمرده نمی‌شود
+CREATE TABLE IF NOT EXISTS processed_email_events (
+    event_id VARCHAR(128) NOT NULL,
+    scope VARCHAR(32) NOT NULL,
+    processed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, scope)
+);
+
+CREATE INDEX IF NOT EXISTS idx_processed_email_events_processed ON processed_email_events (processed_at);
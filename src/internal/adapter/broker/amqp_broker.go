@@ -0,0 +1,332 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const (
+	consumeRetryBase = time.Second
+	consumeRetryMax  = 30 * time.Second
+)
+
+// amqpBroker پیاده‌سازی MessageBroker روی RabbitMQ
+//
+// انتشار روی یک کانال مشترک در حالت Confirm انجام می‌شود و هر مصرف‌کننده کانال جداگانه با Qos خودش دارد.
+// با قطع اتصال، کانال انتشار در فراخوانی بعدی دوباره ساخته می‌شود و مصرف‌کننده‌ها با Backoff دوباره وصل می‌شوند.
+type amqpBroker struct {
+	url            string
+	prefetch       int
+	publishTimeout time.Duration
+
+	mu     sync.Mutex
+	conn   *amqp.Connection
+	pubCh  *amqp.Channel
+	closed bool
+}
+
+func NewAMQPBroker(url string, prefetch int, publishTimeout time.Duration) (port.MessageBroker, error) {
+	if prefetch <= 0 {
+		prefetch = defaultPrefetch
+	}
+	if publishTimeout <= 0 {
+		publishTimeout = defaultPublishTimeout
+	}
+	b := &amqpBroker{url: url, prefetch: prefetch, publishTimeout: publishTimeout}
+
+	b.mu.Lock()
+	_, err := b.connection()
+	b.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *amqpBroker) DeclareExchange(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("exchange name is required")
+	}
+	return b.withChannel(func(ch *amqp.Channel) error {
+		return ch.ExchangeDeclare(name, amqp.ExchangeTopic, true, false, false, false, nil)
+	})
+}
+
+func (b *amqpBroker) DeclareQueue(ctx context.Context, name, exchange string, bindingKeys ...string) error {
+	if name == "" {
+		return errors.New("queue name is required")
+	}
+	return b.withChannel(func(ch *amqp.Channel) error {
+		if _, err := ch.QueueDeclare(name, true, false, false, false, nil); err != nil {
+			return err
+		}
+		if exchange == "" {
+			return nil
+		}
+		for _, key := range bindingKeys {
+			if err := ch.QueueBind(name, key, exchange, false, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (b *amqpBroker) Publish(ctx context.Context, exchange, routingKey string, msg port.BrokerMessage) error {
+	ctx, cancel := context.WithTimeout(ctx, b.publishTimeout)
+	defer cancel()
+
+	pub := amqp.Publishing{
+		MessageId:   msg.MessageID,
+		ContentType: msg.ContentType,
+		Headers:     amqp.Table(msg.Headers),
+		Body:        msg.Body,
+		Priority:    msg.Priority,
+		Timestamp:   msg.Timestamp,
+	}
+	if pub.Timestamp.IsZero() {
+		pub.Timestamp = time.Now()
+	}
+	if msg.Persistent {
+		pub.DeliveryMode = amqp.Persistent
+	}
+
+	// فقط ارسال زیر قفل انجام می‌شود تا انتظار برای Confirm انتشارهای همزمان را پشت سر هم قرار ندهد
+	b.mu.Lock()
+	ch, err := b.publishChannel()
+	var confirm *amqp.DeferredConfirmation
+	if err == nil {
+		confirm, err = ch.PublishWithDeferredConfirmWithContext(ctx, exchange, routingKey, false, false, pub)
+	}
+	b.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("publish to %q/%q: %w", exchange, routingKey, err)
+	}
+
+	// با بسته شدن کانال (مثلاً exchange ناموجود) Confirm های در انتظار Nack می‌شوند
+	ok, err := confirm.WaitContext(ctx)
+	if err != nil {
+		return fmt.Errorf("publish to %q/%q: %w", exchange, routingKey, err)
+	}
+	if !ok {
+		return fmt.Errorf("publish to %q/%q: %w", exchange, routingKey, port.ErrPublishNotConfirmed)
+	}
+	return nil
+}
+
+func (b *amqpBroker) Consume(ctx context.Context, queue string, prefetch int) (<-chan port.Delivery, error) {
+	if prefetch <= 0 {
+		prefetch = b.prefetch
+	}
+	// اتصال اول همزمان انجام می‌شود تا خطاهایی مثل صف ناموجود به فراخواننده برگردد
+	ch, deliveries, err := b.subscribe(queue, prefetch)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan port.Delivery)
+	go func() {
+		defer close(out)
+		retry := consumeRetryBase
+		for {
+			b.forward(ctx, deliveries, out)
+			// بستن کانال پیام‌های Ack نشده را به صف برمی‌گرداند
+			ch.Close()
+			if ctx.Err() != nil || b.isClosed() {
+				return
+			}
+
+			// اتصال قطع شده است
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(retry):
+				}
+				if ch, deliveries, err = b.subscribe(queue, prefetch); err == nil {
+					retry = consumeRetryBase
+					break
+				}
+				if b.isClosed() {
+					return
+				}
+				log.Printf("⚠️ broker: failed to resubscribe to queue %s: %v", queue, err)
+				if retry *= 2; retry > consumeRetryMax {
+					retry = consumeRetryMax
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (b *amqpBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil
+	}
+	b.closed = true
+	if b.conn == nil || b.conn.IsClosed() {
+		return nil
+	}
+	return b.conn.Close()
+}
+
+// ---------------------------------------------------------
+// توابع کمکی
+// ---------------------------------------------------------
+
+// connection اتصال فعلی یا اتصال دوباره در صورت قطع شدن (قفل باید گرفته شده باشد)
+func (b *amqpBroker) connection() (*amqp.Connection, error) {
+	if b.closed {
+		return nil, errBrokerClosed
+	}
+	if b.conn != nil && !b.conn.IsClosed() {
+		return b.conn, nil
+	}
+	conn, err := amqp.Dial(b.url)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to broker: %w", err)
+	}
+	b.conn = conn
+	b.pubCh = nil
+	return conn, nil
+}
+
+// publishChannel کانال انتشار در حالت Confirm (قفل باید گرفته شده باشد)
+func (b *amqpBroker) publishChannel() (*amqp.Channel, error) {
+	conn, err := b.connection()
+	if err != nil {
+		return nil, err
+	}
+	if b.pubCh != nil && !b.pubCh.IsClosed() {
+		return b.pubCh, nil
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+	if err := ch.Confirm(false); err != nil {
+		ch.Close()
+		return nil, err
+	}
+	b.pubCh = ch
+	return ch, nil
+}
+
+// withChannel تعریف‌ها روی کانال موقت انجام می‌شوند چون خطای سرور کانال را می‌بندد
+func (b *amqpBroker) withChannel(fn func(ch *amqp.Channel) error) error {
+	b.mu.Lock()
+	conn, err := b.connection()
+	b.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+	return fn(ch)
+}
+
+func (b *amqpBroker) subscribe(queue string, prefetch int) (*amqp.Channel, <-chan amqp.Delivery, error) {
+	b.mu.Lock()
+	conn, err := b.connection()
+	b.mu.Unlock()
+	if err != nil {
+		return nil, nil, err
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := ch.Qos(prefetch, 0, false); err != nil {
+		ch.Close()
+		return nil, nil, err
+	}
+	deliveries, err := ch.Consume(queue, "", false, false, false, false, nil)
+	if err != nil {
+		ch.Close()
+		return nil, nil, fmt.Errorf("consume queue %q: %w", queue, err)
+	}
+	return ch, deliveries, nil
+}
+
+// forward تا لغو ctx یا بسته شدن کانال پیام‌ها را منتقل می‌کند؛ پیامی که تحویل نشود با بستن کانال به صف برمی‌گردد
+func (b *amqpBroker) forward(ctx context.Context, deliveries <-chan amqp.Delivery, out chan<- port.Delivery) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case d, ok := <-deliveries:
+			if !ok {
+				return
+			}
+			select {
+			case out <- &amqpDelivery{d: d}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+func (b *amqpBroker) isClosed() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.closed
+}
+
+// amqpDelivery؛ Ack/Nack دوباره به سرور فرستاده نمی‌شود چون RabbitMQ با آن کانال مصرف‌کننده را می‌بندد
+type amqpDelivery struct {
+	d       amqp.Delivery
+	settled atomic.Bool
+}
+
+func (d *amqpDelivery) Message() port.BrokerMessage {
+	return port.BrokerMessage{
+		MessageID:   d.d.MessageId,
+		ContentType: d.d.ContentType,
+		Headers:     map[string]interface{}(d.d.Headers),
+		Body:        d.d.Body,
+		Priority:    d.d.Priority,
+		Persistent:  d.d.DeliveryMode == amqp.Persistent,
+		Timestamp:   d.d.Timestamp,
+	}
+}
+
+func (d *amqpDelivery) Exchange() string   { return d.d.Exchange }
+func (d *amqpDelivery) RoutingKey() string { return d.d.RoutingKey }
+func (d *amqpDelivery) Redelivered() bool  { return d.d.Redelivered }
+
+func (d *amqpDelivery) Ack() error {
+	if !d.settled.CompareAndSwap(false, true) {
+		return port.ErrDeliveryAcknowledged
+	}
+	return ackError(d.d.Ack(false))
+}
+
+func (d *amqpDelivery) Nack(requeue bool) error {
+	if !d.settled.CompareAndSwap(false, true) {
+		return port.ErrDeliveryAcknowledged
+	}
+	return ackError(d.d.Nack(false, requeue))
+}
+
+// ackError؛ پیام کانال بسته شده توسط RabbitMQ دوباره تحویل داده می‌شود
+func ackError(err error) error {
+	if errors.Is(err, amqp.ErrClosed) {
+		return port.ErrDeliveryAcknowledged
+	}
+	return err
+}
@@ -0,0 +1,57 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/configs"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+)
+
+const (
+	DriverAMQP   = "amqp"
+	DriverMemory = "memory"
+
+	defaultPrefetch       = 10
+	defaultPublishTimeout = 5 * time.Second
+)
+
+// NewBroker پیاده‌سازی MessageBroker را بر اساس cfg.Driver می‌سازد و exchange اصلی را تعریف می‌کند
+func NewBroker(cfg configs.BrokerConfig) (port.MessageBroker, error) {
+	prefetch := cfg.Prefetch
+	if prefetch <= 0 {
+		prefetch = defaultPrefetch
+	}
+	timeout := cfg.PublishTimeout
+	if timeout <= 0 {
+		timeout = defaultPublishTimeout
+	}
+
+	var b port.MessageBroker
+	switch cfg.Driver {
+	case DriverAMQP:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("broker url is required for driver %q", DriverAMQP)
+		}
+		ab, err := NewAMQPBroker(cfg.URL, prefetch, timeout)
+		if err != nil {
+			return nil, err
+		}
+		b = ab
+	case DriverMemory, "":
+		b = NewMemoryBroker(prefetch)
+	default:
+		return nil, fmt.Errorf("unknown broker driver %q", cfg.Driver)
+	}
+
+	if cfg.Exchange != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		if err := b.DeclareExchange(ctx, cfg.Exchange); err != nil {
+			b.Close()
+			return nil, fmt.Errorf("error declaring exchange %q: %w", cfg.Exchange, err)
+		}
+	}
+	return b, nil
+}
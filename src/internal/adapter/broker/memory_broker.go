@@ -0,0 +1,349 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+)
+
+var errBrokerClosed = errors.New("broker is closed")
+
+// memoryBroker نسخه درون‌حافظه‌ای برای تست و اجرای محلی با همان معنای RabbitMQ:
+// مسیریابی topic، Ack/Nack، Prefetch برای هر مصرف‌کننده و بازگشت پیام‌های Ack نشده به صف با قطع مصرف‌کننده.
+// پیام‌ها پایدار نیستند و با توقف سرویس از بین می‌روند.
+type memoryBroker struct {
+	mu        sync.Mutex
+	prefetch  int
+	exchanges map[string][]memBinding
+	queues    map[string]*memQueue
+	seq       uint64
+	closed    bool
+}
+
+type memBinding struct {
+	queue string
+	key   string
+}
+
+type memQueue struct {
+	ready []*memMessage // به ترتیب seq
+	wake  chan struct{} // با هر تغییر صف یا آزاد شدن ظرفیت Prefetch بسته و جایگزین می‌شود
+}
+
+type memMessage struct {
+	seq         uint64
+	msg         port.BrokerMessage
+	exchange    string
+	routingKey  string
+	redelivered bool
+}
+
+func NewMemoryBroker(prefetch int) port.MessageBroker {
+	if prefetch <= 0 {
+		prefetch = defaultPrefetch
+	}
+	return &memoryBroker{
+		prefetch:  prefetch,
+		exchanges: make(map[string][]memBinding),
+		queues:    make(map[string]*memQueue),
+	}
+}
+
+func (b *memoryBroker) DeclareExchange(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("exchange name is required")
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return errBrokerClosed
+	}
+	if _, ok := b.exchanges[name]; !ok {
+		b.exchanges[name] = nil
+	}
+	return nil
+}
+
+func (b *memoryBroker) DeclareQueue(ctx context.Context, name, exchange string, bindingKeys ...string) error {
+	if name == "" {
+		return errors.New("queue name is required")
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return errBrokerClosed
+	}
+	if exchange != "" {
+		if _, ok := b.exchanges[exchange]; !ok {
+			return fmt.Errorf("exchange %q not found", exchange)
+		}
+	}
+	if _, ok := b.queues[name]; !ok {
+		b.queues[name] = &memQueue{wake: make(chan struct{})}
+	}
+	if exchange == "" {
+		return nil
+	}
+	for _, key := range bindingKeys {
+		exists := false
+		for _, bd := range b.exchanges[exchange] {
+			if bd.queue == name && bd.key == key {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			b.exchanges[exchange] = append(b.exchanges[exchange], memBinding{queue: name, key: key})
+		}
+	}
+	return nil
+}
+
+// Publish پیام را در همه صف‌های منطبق قرار می‌دهد؛ بازگشت بدون خطا معادل Confirm در RabbitMQ است
+func (b *memoryBroker) Publish(ctx context.Context, exchange, routingKey string, msg port.BrokerMessage) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return errBrokerClosed
+	}
+
+	var targets []*memQueue
+	if exchange == "" {
+		// exchange پیش‌فرض: کلید مسیریابی همان نام صف است
+		if q, ok := b.queues[routingKey]; ok {
+			targets = append(targets, q)
+		}
+	} else {
+		bindings, ok := b.exchanges[exchange]
+		if !ok {
+			return fmt.Errorf("exchange %q not found", exchange)
+		}
+		seen := make(map[string]bool)
+		for _, bd := range bindings {
+			if !seen[bd.queue] && topicMatch(bd.key, routingKey) {
+				seen[bd.queue] = true
+				targets = append(targets, b.queues[bd.queue])
+			}
+		}
+	}
+
+	if msg.Timestamp.IsZero() {
+		msg.Timestamp = time.Now()
+	}
+	for _, q := range targets {
+		b.seq++
+		q.ready = append(q.ready, &memMessage{seq: b.seq, msg: cloneMessage(msg), exchange: exchange, routingKey: routingKey})
+		q.notify()
+	}
+	return nil
+}
+
+func (b *memoryBroker) Consume(ctx context.Context, queue string, prefetch int) (<-chan port.Delivery, error) {
+	b.mu.Lock()
+	q, ok := b.queues[queue]
+	closed := b.closed
+	b.mu.Unlock()
+	if closed {
+		return nil, errBrokerClosed
+	}
+	if !ok {
+		return nil, fmt.Errorf("queue %q not found", queue)
+	}
+	if prefetch <= 0 {
+		prefetch = b.prefetch
+	}
+
+	c := &memConsumer{broker: b, queue: q, prefetch: prefetch, unacked: make(map[*memDelivery]bool)}
+	out := make(chan port.Delivery)
+	go c.run(ctx, out)
+	return out, nil
+}
+
+func (b *memoryBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil
+	}
+	b.closed = true
+	for _, q := range b.queues {
+		q.notify()
+	}
+	return nil
+}
+
+// ---------------------------------------------------------
+// مصرف‌کننده
+// ---------------------------------------------------------
+
+type memConsumer struct {
+	broker   *memoryBroker
+	queue    *memQueue
+	prefetch int
+	unacked  map[*memDelivery]bool
+}
+
+func (c *memConsumer) run(ctx context.Context, out chan<- port.Delivery) {
+	defer close(out)
+	// مانند بسته شدن کانال در RabbitMQ، پیام‌های Ack نشده به صف برمی‌گردند
+	defer c.cancel()
+
+	b := c.broker
+	for {
+		b.mu.Lock()
+		if b.closed {
+			b.mu.Unlock()
+			return
+		}
+		if len(c.unacked) < c.prefetch && len(c.queue.ready) > 0 {
+			m := c.queue.ready[0]
+			c.queue.ready = c.queue.ready[1:]
+			d := &memDelivery{consumer: c, m: m, redelivered: m.redelivered}
+			c.unacked[d] = true
+			b.mu.Unlock()
+
+			select {
+			case out <- d:
+				continue
+			case <-ctx.Done():
+				d.undelivered = true
+				return
+			}
+		}
+		wake := c.queue.wake
+		b.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-wake:
+		}
+	}
+}
+
+func (c *memConsumer) cancel() {
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	for d := range c.unacked {
+		delete(c.unacked, d)
+		c.queue.requeue(d.m, !d.undelivered)
+	}
+	c.queue.notify()
+}
+
+type memDelivery struct {
+	consumer    *memConsumer
+	m           *memMessage
+	redelivered bool // مقدار هنگام تحویل؛ m ممکن است بعداً دوباره به صف برگردد
+	undelivered bool // به دست مصرف‌کننده نرسید (لغو ctx هنگام تحویل)
+}
+
+func (d *memDelivery) Message() port.BrokerMessage { return d.m.msg }
+func (d *memDelivery) Exchange() string            { return d.m.exchange }
+func (d *memDelivery) RoutingKey() string          { return d.m.routingKey }
+func (d *memDelivery) Redelivered() bool           { return d.redelivered }
+
+func (d *memDelivery) Ack() error {
+	return d.settle(false)
+}
+
+func (d *memDelivery) Nack(requeue bool) error {
+	return d.settle(requeue)
+}
+
+func (d *memDelivery) settle(requeue bool) error {
+	c := d.consumer
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	if !c.unacked[d] {
+		return port.ErrDeliveryAcknowledged
+	}
+	delete(c.unacked, d)
+	if requeue {
+		c.queue.requeue(d.m, true)
+	}
+	// ظرفیت Prefetch آزاد شد
+	c.queue.notify()
+	return nil
+}
+
+// ---------------------------------------------------------
+// توابع کمکی
+// ---------------------------------------------------------
+
+// notify مصرف‌کننده‌های منتظر را بیدار می‌کند (قفل broker باید گرفته شده باشد)
+func (q *memQueue) notify() {
+	close(q.wake)
+	q.wake = make(chan struct{})
+}
+
+// requeue پیام را در جایگاه اصلی خود (بر اساس ترتیب انتشار) به صف برمی‌گرداند
+func (q *memQueue) requeue(m *memMessage, redelivered bool) {
+	m.redelivered = m.redelivered || redelivered
+	i := sort.Search(len(q.ready), func(i int) bool { return q.ready[i].seq > m.seq })
+	q.ready = append(q.ready, nil)
+	copy(q.ready[i+1:], q.ready[i:])
+	q.ready[i] = m
+}
+
+func cloneMessage(msg port.BrokerMessage) port.BrokerMessage {
+	msg.Body = append([]byte(nil), msg.Body...)
+	if msg.Headers != nil {
+		headers := make(map[string]interface{}, len(msg.Headers))
+		for k, v := range msg.Headers {
+			headers[k] = v
+		}
+		msg.Headers = headers
+	}
+	return msg
+}
+
+// topicMatch تطبیق کلید مسیریابی با الگوی binding مانند exchange نوع topic:
+// کلمات با نقطه جدا می‌شوند، * دقیقاً یک کلمه و # صفر یا چند کلمه
+func topicMatch(pattern, key string) bool {
+	return matchWords(splitWords(pattern), splitWords(key))
+}
+
+func splitWords(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ".")
+}
+
+func matchWords(pattern, key []string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case "#":
+			// # آخر الگو همه کلمات باقیمانده را می‌گیرد
+			if len(pattern) == 1 {
+				return true
+			}
+			for i := 0; i <= len(key); i++ {
+				if matchWords(pattern[1:], key[i:]) {
+					return true
+				}
+			}
+			return false
+		case "*":
+			if len(key) == 0 {
+				return false
+			}
+		default:
+			if len(key) == 0 || key[0] != pattern[0] {
+				return false
+			}
+		}
+		pattern, key = pattern[1:], key[1:]
+	}
+	return len(key) == 0
+}
@@ -0,0 +1,50 @@
+package grpcclient
+
+import (
+	"context"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	audiencepb "github.com/ehsanshah/campaign-services/src/pkg/pb/audience/v1"
+	"google.golang.org/grpc"
+)
+
+// اندازه صفحه هنگام خواندن اعضای لیست
+const audiencePageSize = 500
+
+type audienceClient struct {
+	client audiencepb.IAudienceManagementservicesClient
+}
+
+func NewAudienceClient(conn grpc.ClientConnInterface) port.IAudienceClient {
+	return &audienceClient{client: audiencepb.NewIAudienceManagementservicesClient(conn)}
+}
+
+func (c *audienceClient) ListMembers(ctx context.Context, accountID, listID, groupID, pageToken string) ([]domain.AudienceMember, string, error) {
+	resp, err := c.client.ListMembers(ctx, &audiencepb.ListMembersRequest{
+		AccountId: accountID,
+		ListId:    listID,
+		GroupId:   groupID,
+		Limit:     audiencePageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	members := make([]domain.AudienceMember, 0, len(resp.GetMembers()))
+	for _, m := range resp.GetMembers() {
+		member := domain.AudienceMember{
+			Email:      m.GetEmail(),
+			FirstName:  m.GetFirstName(),
+			LastName:   m.GetLastName(),
+			Attributes: m.GetAttributes(),
+			Status:     m.GetStatus(),
+		}
+		if m.GetJoinedAt() != nil {
+			member.JoinedAt = m.GetJoinedAt().AsTime()
+		}
+		members = append(members, member)
+	}
+	return members, resp.GetNextPageToken(), nil
+}
@@ -0,0 +1,63 @@
+package grpcclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	reportspb "github.com/ehsanshah/campaign-services/src/pkg/pb/reports/v1"
+	"google.golang.org/grpc"
+)
+
+// اندازه صفحه هنگام خواندن رویدادها
+const eventsPageSize = 1000
+
+type eventsClient struct {
+	client reportspb.EventServiceClient
+}
+
+func NewEventsClient(conn grpc.ClientConnInterface) port.IEventsClient {
+	return &eventsClient{client: reportspb.NewEventServiceClient(conn)}
+}
+
+func (c *eventsClient) ListEvents(ctx context.Context, accountID string, filter domain.EventFilter, page int) ([]domain.EmailEvent, int, error) {
+	resp, err := c.client.ListEvents(ctx, &reportspb.ListEventsRequest{
+		ClientId:      accountID,
+		TransactionId: filter.CampaignID,
+		EventType:     filter.Type,
+		FromDate:      formatEventDate(filter.From),
+		ToDate:        formatEventDate(filter.To),
+		Page:          int32(page),
+		PageSize:      eventsPageSize,
+		SortBy:        "event_at",
+		SortOrder:     "asc",
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	events := make([]domain.EmailEvent, 0, len(resp.GetItems()))
+	for _, e := range resp.GetItems() {
+		event := domain.EmailEvent{
+			ID:         e.GetEventId(),
+			AccountID:  e.GetClientId(),
+			CampaignID: e.GetTransactionId(),
+			Type:       e.GetEventType(),
+			Email:      e.GetEmail(),
+			IPAddress:  e.GetIpAddress(),
+			UserAgent:  e.GetUserAgent(),
+		}
+		// رویداد با زمان نامعتبر کنار گذاشته نمی‌شود؛ فقط زمان آن صفر می‌ماند
+		event.At, _ = time.Parse(time.RFC3339, e.GetEventAt())
+		events = append(events, event)
+	}
+	return events, int(resp.GetTotal()), nil
+}
+
+func formatEventDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,59 @@
+package grpcclient
+
+import (
+	"context"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	mtapb "github.com/ehsanshah/campaign-services/src/pkg/pb/mta/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// SendEmailRequest فیلد جداگانه‌ای برای سرآیندها و متن ساده ندارد؛ هر دو در Metadata با کلیدهای headers و body_text فرستاده می‌شوند
+type mtaClient struct {
+	client mtapb.IEmailDeliveryservicesClient
+}
+
+func NewMtaClient(conn grpc.ClientConnInterface) port.IMtaService {
+	return &mtaClient{client: mtapb.NewIEmailDeliveryservicesClient(conn)}
+}
+
+func (c *mtaClient) SendImmediate(ctx context.Context, to string, subject string, html string) error {
+	_, err := c.Send(ctx, &domain.OutboundEmail{To: to, Subject: subject, HTML: html})
+	return err
+}
+
+func (c *mtaClient) Send(ctx context.Context, email *domain.OutboundEmail) (string, error) {
+	fields := make(map[string]interface{}, len(email.Metadata)+2)
+	for k, v := range email.Metadata {
+		fields[k] = v
+	}
+	if len(email.Headers) > 0 {
+		headers := make(map[string]interface{}, len(email.Headers))
+		for k, v := range email.Headers {
+			headers[k] = v
+		}
+		fields["headers"] = headers
+	}
+	if email.Text != "" {
+		fields["body_text"] = email.Text
+	}
+	metadata, err := structpb.NewStruct(fields)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.client.SendEmail(ctx, &mtapb.SendEmailRequest{
+		AccountId: email.AccountID,
+		From:      email.From,
+		To:        []string{email.To},
+		Subject:   email.Subject,
+		BodyHtml:  email.HTML,
+		Metadata:  metadata,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetMessageId(), nil
+}
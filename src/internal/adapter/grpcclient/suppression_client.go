@@ -0,0 +1,75 @@
+package grpcclient
+
+import (
+	"context"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	audiencepb "github.com/ehsanshah/campaign-services/src/pkg/pb/audience/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// اندازه صفحه هنگام خواندن فهرست Suppression
+const suppressionPageSize = 5000
+
+type suppressionClient struct {
+	client audiencepb.ISuppressionServicesClient
+}
+
+func NewSuppressionClient(conn grpc.ClientConnInterface) port.ISuppressionClient {
+	return &suppressionClient{client: audiencepb.NewISuppressionServicesClient(conn)}
+}
+
+func (c *suppressionClient) ListSuppressions(ctx context.Context, accountID string, pageToken []byte) ([]domain.Suppression, []byte, error) {
+	resp, err := c.client.ListSuppressions(ctx, &audiencepb.ListSuppressionsRequest{
+		AccountId: accountID,
+		Limit:     suppressionPageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items := make([]domain.Suppression, 0, len(resp.GetSuppressions()))
+	for _, s := range resp.GetSuppressions() {
+		items = append(items, toSuppression(s))
+	}
+	return items, resp.GetNextPageToken(), nil
+}
+
+func (c *suppressionClient) GetSuppression(ctx context.Context, accountID, email string) (*domain.Suppression, error) {
+	resp, err := c.client.GetSuppression(ctx, &audiencepb.GetSuppressionRequest{AccountId: accountID, Email: email})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetEmail() == "" {
+		return nil, nil
+	}
+	s := toSuppression(resp)
+	return &s, nil
+}
+
+func (c *suppressionClient) BulkAddSuppressions(ctx context.Context, accountID string, items []domain.Suppression) (int, error) {
+	req := &audiencepb.BulkAddSuppressionsRequest{AccountId: accountID, Items: make([]*audiencepb.SuppressionItem, 0, len(items))}
+	for _, s := range items {
+		req.Items = append(req.Items, &audiencepb.SuppressionItem{Email: s.Email, Reason: s.Reason})
+	}
+	resp, err := c.client.BulkAddSuppressions(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	return int(resp.GetProcessedCount()), nil
+}
+
+func toSuppression(s *audiencepb.SuppressionResponse) domain.Suppression {
+	item := domain.Suppression{Email: s.GetEmail(), Reason: s.GetReason()}
+	if s.GetCreatedAt() != nil {
+		item.CreatedAt = s.GetCreatedAt().AsTime()
+	}
+	return item
+}
@@ -0,0 +1,485 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	pb "github.com/ehsanshah/campaign-services/src/pkg/pb/camp/v1"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const defaultJourneyPageSize = 50
+
+type AutomationHandler struct {
+	pb.UnimplementedAutomationServiceServer
+	service  port.IAutomationService
+	triggers port.IAutomationTriggerService
+}
+
+func NewAutomationHandler(service port.IAutomationService, triggers port.IAutomationTriggerService) *AutomationHandler {
+	return &AutomationHandler{service: service, triggers: triggers}
+}
+
+func (h *AutomationHandler) CreateAutomation(ctx context.Context, req *pb.CreateAutomationRequest) (*pb.AutomationResponse, error) {
+	a, err := automationFromProto(req.Automation)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid automation: %v", err)
+	}
+	created, err := h.service.CreateAutomation(ctx, a)
+	return automationResponse(created, err, "create")
+}
+
+func (h *AutomationHandler) UpdateAutomation(ctx context.Context, req *pb.UpdateAutomationRequest) (*pb.AutomationResponse, error) {
+	a, err := automationFromProto(req.Automation)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid automation: %v", err)
+	}
+	if a.ID.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "automation id is required")
+	}
+	updated, err := h.service.UpdateAutomation(ctx, a)
+	return automationResponse(updated, err, "update")
+}
+
+func (h *AutomationHandler) GetAutomation(ctx context.Context, req *pb.AutomationRequest) (*pb.AutomationResponse, error) {
+	a, err := h.service.GetAutomation(ctx, req.AccountId, req.AutomationId)
+	return automationResponse(a, err, "get")
+}
+
+func (h *AutomationHandler) ListAutomations(ctx context.Context, req *pb.ListAutomationsRequest) (*pb.ListAutomationsResponse, error) {
+	automations, total, err := h.service.ListAutomations(ctx, req.AccountId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list automations: %v", err)
+	}
+
+	resp := &pb.ListAutomationsResponse{Total: int32(total)}
+	for _, a := range automations {
+		resp.Automations = append(resp.Automations, automationToProto(a))
+	}
+	return resp, nil
+}
+
+func (h *AutomationHandler) ActivateAutomation(ctx context.Context, req *pb.AutomationRequest) (*pb.AutomationResponse, error) {
+	a, err := h.service.ActivateAutomation(ctx, req.AccountId, req.AutomationId)
+	return automationResponse(a, err, "activate")
+}
+
+func (h *AutomationHandler) DeactivateAutomation(ctx context.Context, req *pb.AutomationRequest) (*pb.AutomationResponse, error) {
+	a, err := h.service.DeactivateAutomation(ctx, req.AccountId, req.AutomationId)
+	return automationResponse(a, err, "deactivate")
+}
+
+func (h *AutomationHandler) DeleteAutomation(ctx context.Context, req *pb.AutomationRequest) (*pb.DeleteAutomationResponse, error) {
+	if err := h.service.DeleteAutomation(ctx, req.AccountId, req.AutomationId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete automation: %v", err)
+	}
+	return &pb.DeleteAutomationResponse{Success: true}, nil
+}
+
+func (h *AutomationHandler) IngestEvent(ctx context.Context, req *pb.IngestEventRequest) (*pb.IngestEventResponse, error) {
+	ev := req.Event
+	if ev == nil {
+		return nil, status.Error(codes.InvalidArgument, "event is required")
+	}
+	res, err := h.triggers.IngestEvent(ctx, &domain.AutomationEvent{
+		AccountID:    ev.AccountId,
+		EventType:    ev.EventType,
+		Email:        ev.Email,
+		SubscriberID: ev.SubscriberId,
+		Data:         ev.Data.AsMap(),
+		OccurredAt:   pbToTime(ev.OccurredAt),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to ingest event: %v", err)
+	}
+	return &pb.IngestEventResponse{
+		MatchedAutomations:   int32(res.MatchedAutomations),
+		EnrolledJourneys:     res.EnrolledJourneys,
+		Skipped:              res.Skipped,
+		AttributedAutomation: res.AttributedAutomation,
+	}, nil
+}
+
+type AutomationReportHandler struct {
+	pb.UnimplementedAutomationReportServiceServer
+	service port.IAutomationService
+}
+
+func NewAutomationReportHandler(service port.IAutomationService) *AutomationReportHandler {
+	return &AutomationReportHandler{service: service}
+}
+
+func (h *AutomationReportHandler) GetAutomationFunnel(ctx context.Context, req *pb.GetAutomationFunnelRequest) (*pb.AutomationFunnelResponse, error) {
+	f, err := h.service.GetAutomationFunnel(ctx, req.AccountId, req.AutomationId, pbToTime(req.From), pbToTime(req.To))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get automation funnel: %v", err)
+	}
+
+	resp := &pb.AutomationFunnelResponse{
+		AutomationId: f.AutomationID,
+		From:         timeToPb(f.From),
+		To:           timeToPb(f.To),
+		Entries:      int32(f.Entries),
+		Completions:  int32(f.Completions),
+		Exited:       int32(f.Exited),
+		Conversions:  int32(f.Conversions),
+		Revenue:      f.Revenue,
+	}
+	for _, s := range f.Steps {
+		resp.Steps = append(resp.Steps, &pb.FunnelStep{
+			StepId:         s.StepID,
+			StepNumber:     int32(s.StepNumber),
+			StepType:       s.StepType,
+			Name:           s.Name,
+			Entries:        int32(s.Entries),
+			Completions:    int32(s.Completions),
+			DropOffs:       int32(s.DropOffs),
+			CompletionRate: s.CompletionRate,
+			DropOffRate:    s.DropOffRate,
+			EmailsSent:     int32(s.EmailsSent),
+			EmailsOpened:   int32(s.EmailsOpened),
+			EmailsClicked:  int32(s.EmailsClicked),
+		})
+	}
+	return resp, nil
+}
+
+type JourneyHandler struct {
+	pb.UnimplementedJourneyServiceServer
+	service port.IJourneyService
+}
+
+func NewJourneyHandler(service port.IJourneyService) *JourneyHandler {
+	return &JourneyHandler{service: service}
+}
+
+func (h *JourneyHandler) ListJourneys(ctx context.Context, req *pb.ListJourneysRequest) (*pb.ListJourneysResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultJourneyPageSize
+	}
+	filter := domain.JourneyFilter{AutomationID: req.AutomationId, Email: req.Email, Status: req.Status}
+	journeys, total, err := h.service.ListJourneys(ctx, req.AccountId, filter, limit, int(req.Offset))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list journeys: %v", err)
+	}
+
+	resp := &pb.ListJourneysResponse{Total: int32(total)}
+	for _, j := range journeys {
+		resp.Journeys = append(resp.Journeys, journeyToProto(j))
+	}
+	return resp, nil
+}
+
+func (h *JourneyHandler) PauseJourney(ctx context.Context, req *pb.JourneyActionRequest) (*pb.JourneyResponse, error) {
+	j, err := h.service.PauseJourney(ctx, req.AccountId, req.JourneyId, domain.JourneyAction{Actor: req.Actor, Reason: req.Reason})
+	return journeyResponse(j, err, "pause")
+}
+
+func (h *JourneyHandler) ResumeJourney(ctx context.Context, req *pb.JourneyActionRequest) (*pb.JourneyResponse, error) {
+	j, err := h.service.ResumeJourney(ctx, req.AccountId, req.JourneyId, domain.JourneyAction{Actor: req.Actor, Reason: req.Reason})
+	return journeyResponse(j, err, "resume")
+}
+
+func (h *JourneyHandler) ExitJourney(ctx context.Context, req *pb.JourneyActionRequest) (*pb.JourneyResponse, error) {
+	j, err := h.service.ExitJourney(ctx, req.AccountId, req.JourneyId, domain.JourneyAction{Actor: req.Actor, Reason: req.Reason})
+	return journeyResponse(j, err, "exit")
+}
+
+func (h *JourneyHandler) MoveJourney(ctx context.Context, req *pb.MoveJourneyRequest) (*pb.JourneyResponse, error) {
+	j, err := h.service.MoveJourney(ctx, req.AccountId, req.JourneyId, req.StepId, domain.JourneyAction{Actor: req.Actor, Reason: req.Reason})
+	return journeyResponse(j, err, "move")
+}
+
+func (h *JourneyHandler) ReenrollSubscriber(ctx context.Context, req *pb.ReenrollSubscriberRequest) (*pb.JourneyResponse, error) {
+	j, err := h.service.ReenrollSubscriber(ctx, req.AccountId, req.AutomationId, req.Email, domain.JourneyAction{Actor: req.Actor, Reason: req.Reason})
+	return journeyResponse(j, err, "re-enroll")
+}
+
+func (h *JourneyHandler) PauseAutomationJourneys(ctx context.Context, req *pb.AutomationJourneysRequest) (*pb.AutomationJourneysResponse, error) {
+	n, err := h.service.PauseAutomationJourneys(ctx, req.AccountId, req.AutomationId, domain.JourneyAction{Actor: req.Actor, Reason: req.Reason})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to pause journeys: %v", err)
+	}
+	return &pb.AutomationJourneysResponse{Updated: int32(n)}, nil
+}
+
+func (h *JourneyHandler) ResumeAutomationJourneys(ctx context.Context, req *pb.AutomationJourneysRequest) (*pb.AutomationJourneysResponse, error) {
+	n, err := h.service.ResumeAutomationJourneys(ctx, req.AccountId, req.AutomationId, domain.JourneyAction{Actor: req.Actor, Reason: req.Reason})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resume journeys: %v", err)
+	}
+	return &pb.AutomationJourneysResponse{Updated: int32(n)}, nil
+}
+
+func journeyResponse(j *models.AutomationJourney, err error, action string) (*pb.JourneyResponse, error) {
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to %s journey: %v", action, err)
+	}
+	return &pb.JourneyResponse{Journey: journeyToProto(j)}, nil
+}
+
+func journeyToProto(j *models.AutomationJourney) *pb.Journey {
+	variables, _ := structpb.NewStruct(j.Variables)
+	out := &pb.Journey{
+		Id:                j.ID.Hex(),
+		AutomationId:      j.AutomationID.Hex(),
+		AccountId:         j.AccountID,
+		SubscriberId:      j.SubscriberID.Hex(),
+		SubscriberEmail:   j.SubscriberEmail,
+		CurrentStepId:     j.CurrentStepID.Hex(),
+		CurrentStepNumber: int32(j.CurrentStepNumber),
+		Status:            j.Status,
+		EnteredAt:         optionalTimeToPb(j.EnteredAt),
+		LastUpdatedAt:     optionalTimeToPb(j.LastUpdatedAt),
+		CompletedAt:       optionalTimeToPb(j.CompletedAt),
+		NextStepAt:        optionalTimeToPb(j.NextStepAt),
+		Variables:         variables,
+	}
+	for _, s := range j.StepHistory {
+		stepVars, _ := structpb.NewStruct(s.Variables)
+		out.StepHistory = append(out.StepHistory, &pb.JourneyStep{
+			StepId:          s.StepID.Hex(),
+			StepNumber:      int32(s.StepNumber),
+			StepType:        s.StepType,
+			EnteredAt:       optionalTimeToPb(s.EnteredAt),
+			CompletedAt:     optionalTimeToPb(s.CompletedAt),
+			Status:          s.Status,
+			EmailStatus:     s.EmailStatus,
+			EmailOpenedAt:   optionalTimeToPb(s.EmailOpenedAt),
+			EmailClickedAt:  optionalTimeToPb(s.EmailClickedAt),
+			ConditionResult: s.ConditionResult,
+			SplitGroup:      int32(s.SplitGroup),
+			Variables:       stepVars,
+			ErrorMessage:    s.ErrorMessage,
+		})
+	}
+	return out
+}
+
+func automationResponse(a *models.EmailAutomation, err error, action string) (*pb.AutomationResponse, error) {
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to %s automation: %v", action, err)
+	}
+	return &pb.AutomationResponse{Automation: automationToProto(a)}, nil
+}
+
+// ---------------------------------------------------------
+// تبدیل اتوماسیون بین Proto و Domain
+// ---------------------------------------------------------
+
+// objectID شناسه hex؛ مقدار خالی شناسه صفر است
+func objectID(field, hex string) (primitive.ObjectID, error) {
+	if hex == "" {
+		return primitive.NilObjectID, nil
+	}
+	id, err := primitive.ObjectIDFromHex(hex)
+	if err != nil {
+		return primitive.NilObjectID, fmt.Errorf("invalid %s %q", field, hex)
+	}
+	return id, nil
+}
+
+func hexOrEmpty(id primitive.ObjectID) string {
+	if id.IsZero() {
+		return ""
+	}
+	return id.Hex()
+}
+
+func automationFromProto(in *pb.Automation) (*models.EmailAutomation, error) {
+	if in == nil {
+		return nil, fmt.Errorf("automation is required")
+	}
+	id, err := objectID("automation id", in.Id)
+	if err != nil {
+		return nil, err
+	}
+	clientID, err := objectID("client id", in.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	a := &models.EmailAutomation{
+		ID:          id,
+		ClientID:    clientID,
+		AccountID:   in.AccountId,
+		Name:        in.Name,
+		Description: in.Description,
+		Type:        models.AutomationType(in.Type),
+		OneTimeOnly: in.OneTimeOnly,
+	}
+	for _, t := range in.TriggerEvents {
+		a.TriggerEvents = append(a.TriggerEvents, models.TriggerEvent{
+			EventType:       t.EventType,
+			EventConditions: t.EventConditions.AsMap(),
+			Delay:           time.Duration(t.DelaySeconds) * time.Second,
+		})
+	}
+	for _, st := range in.Steps {
+		step, err := stepFromProto(st)
+		if err != nil {
+			return nil, err
+		}
+		a.Steps = append(a.Steps, step)
+	}
+	if s := in.Settings; s != nil {
+		a.Settings = models.AutomationSettings{
+			TrackingSettings: s.TrackingSettings,
+			SendingHours:     intsFromProto(s.SendingHours),
+			SendingDays:      intsFromProto(s.SendingDays),
+			Timezone:         s.Timezone,
+			UTMParameters:    s.UtmParameters,
+		}
+	}
+	return a, nil
+}
+
+func stepFromProto(in *pb.AutomationStep) (models.AutomationStep, error) {
+	step := models.AutomationStep{
+		StepNumber: int(in.StepNumber),
+		StepType:   in.StepType,
+		Name:       in.Name,
+		Subject:    in.Subject,
+		FromName:   in.FromName,
+		FromEmail:  in.FromEmail,
+		CampaignID: in.CampaignId,
+		DelayTime:  int(in.DelayTime),
+		DelayUnit:  in.DelayUnit,
+		SplitRatio: in.SplitRatio,
+	}
+	ids := []struct {
+		field string
+		hex   string
+		dst   *primitive.ObjectID
+	}{
+		{"step id", in.Id, &step.ID},
+		{"message id", in.MessageId, &step.MessageID},
+		{"positive step id", in.PositiveStepId, &step.PositiveStepID},
+		{"negative step id", in.NegativeStepId, &step.NegativeStepID},
+		{"split winner step id", in.SplitWinnerStepId, &step.SplitWinnerStepID},
+	}
+	for _, f := range ids {
+		id, err := objectID(f.field, f.hex)
+		if err != nil {
+			return step, err
+		}
+		*f.dst = id
+	}
+	for _, hex := range in.SplitStepIds {
+		id, err := objectID("split step id", hex)
+		if err != nil {
+			return step, err
+		}
+		step.SplitStepIDs = append(step.SplitStepIDs, id)
+	}
+	for _, c := range in.Conditions {
+		step.Conditions = append(step.Conditions, models.Condition{Field: c.Field, Operator: c.Operator, Value: c.Value.AsInterface()})
+	}
+	return step, nil
+}
+
+func automationToProto(a *models.EmailAutomation) *pb.Automation {
+	out := &pb.Automation{
+		Id:          a.ID.Hex(),
+		AccountId:   a.AccountID,
+		ClientId:    hexOrEmpty(a.ClientID),
+		Name:        a.Name,
+		Description: a.Description,
+		Type:        string(a.Type),
+		IsActive:    a.IsActive,
+		OneTimeOnly: a.OneTimeOnly,
+		Settings: &pb.AutomationSettings{
+			TrackingSettings: a.Settings.TrackingSettings,
+			SendingHours:     intsToProto(a.Settings.SendingHours),
+			SendingDays:      intsToProto(a.Settings.SendingDays),
+			Timezone:         a.Settings.Timezone,
+			UtmParameters:    a.Settings.UTMParameters,
+		},
+		Statistics: &pb.AutomationStats{
+			EntriesCount:     int32(a.Statistics.EntriesCount),
+			CompletionsCount: int32(a.Statistics.CompletionsCount),
+			ActiveNow:        int32(a.Statistics.ActiveNow),
+			ExitedCount:      int32(a.Statistics.ExitedCount),
+			RevenueGenerated: a.Statistics.RevenueGenerated,
+			LastUpdatedAt:    optionalTimeToPb(a.Statistics.LastUpdatedAt),
+		},
+		CreatedAt: optionalTimeToPb(a.CreatedAt),
+		UpdatedAt: optionalTimeToPb(a.UpdatedAt),
+	}
+	for _, t := range a.TriggerEvents {
+		conditions, _ := structpb.NewStruct(t.EventConditions)
+		out.TriggerEvents = append(out.TriggerEvents, &pb.AutomationTrigger{
+			EventType:       t.EventType,
+			EventConditions: conditions,
+			DelaySeconds:    int64(t.Delay / time.Second),
+		})
+	}
+	for _, st := range a.Steps {
+		step := &pb.AutomationStep{
+			Id:                st.ID.Hex(),
+			StepNumber:        int32(st.StepNumber),
+			StepType:          st.StepType,
+			Name:              st.Name,
+			MessageId:         hexOrEmpty(st.MessageID),
+			Subject:           st.Subject,
+			FromName:          st.FromName,
+			FromEmail:         st.FromEmail,
+			CampaignId:        st.CampaignID,
+			DelayTime:         int32(st.DelayTime),
+			DelayUnit:         st.DelayUnit,
+			PositiveStepId:    hexOrEmpty(st.PositiveStepID),
+			NegativeStepId:    hexOrEmpty(st.NegativeStepID),
+			SplitRatio:        st.SplitRatio,
+			SplitWinnerStepId: hexOrEmpty(st.SplitWinnerStepID),
+			Statistics:        stepStatsToProto(st.Statistics),
+		}
+		for _, c := range st.Conditions {
+			value, _ := structpb.NewValue(c.Value)
+			step.Conditions = append(step.Conditions, &pb.AutomationCondition{Field: c.Field, Operator: c.Operator, Value: value})
+		}
+		for _, id := range st.SplitStepIDs {
+			step.SplitStepIds = append(step.SplitStepIds, id.Hex())
+		}
+		for _, s := range st.SplitStats {
+			step.SplitStats = append(step.SplitStats, stepStatsToProto(s))
+		}
+		out.Steps = append(out.Steps, step)
+	}
+	return out
+}
+
+func stepStatsToProto(s models.StepStats) *pb.AutomationStepStats {
+	return &pb.AutomationStepStats{
+		EntriesCount:     int32(s.EntriesCount),
+		CompletionsCount: int32(s.CompletionsCount),
+		DropOffs:         int32(s.DropOffs),
+		EmailsSent:       int32(s.EmailsSent),
+		EmailsOpened:     int32(s.EmailsOpened),
+		EmailsClicked:    int32(s.EmailsClicked),
+		ConversionRate:   s.ConversionRate,
+		LastUpdatedAt:    optionalTimeToPb(s.LastUpdatedAt),
+	}
+}
+
+func intsFromProto(in []int32) []int {
+	out := make([]int, 0, len(in))
+	for _, v := range in {
+		out = append(out, int(v))
+	}
+	return out
+}
+
+func intsToProto(in []int) []int32 {
+	out := make([]int32, 0, len(in))
+	for _, v := range in {
+		out = append(out, int32(v))
+	}
+	return out
+}
@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	pb "github.com/ehsanshah/campaign-services/src/pkg/pb/camp/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CampaignReportHandler struct {
+	pb.UnimplementedCampaignReportServiceServer
+	service port.ICampaignService
+}
+
+func NewCampaignReportHandler(service port.ICampaignService) *CampaignReportHandler {
+	return &CampaignReportHandler{service: service}
+}
+
+func (h *CampaignReportHandler) GetCampaignDomainStats(ctx context.Context, req *pb.GetCampaignDomainStatsRequest) (*pb.CampaignDomainStatsResponse, error) {
+	stats, err := h.service.GetCampaignDomainStats(ctx, req.CampaignId, req.AccountId, pbToTime(req.From), pbToTime(req.To), int(req.TopN))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get domain stats: %v", err)
+	}
+
+	resp := &pb.CampaignDomainStatsResponse{
+		CampaignId: stats.CampaignID,
+		From:       timeToPb(stats.From),
+		To:         timeToPb(stats.To),
+		Total:      domainStatToProto(stats.Total),
+		Sampled:    stats.Sampled,
+	}
+	for _, d := range stats.Domains {
+		resp.Domains = append(resp.Domains, domainStatToProto(d))
+	}
+	return resp, nil
+}
+
+func domainStatToProto(s models.DomainStat) *pb.DomainStat {
+	return &pb.DomainStat{
+		Domain:         s.Domain,
+		Count:          int32(s.Count),
+		DeliveryRate:   s.DeliveryRate,
+		OpenRate:       s.OpenRate,
+		ClickRate:      s.ClickRate,
+		BounceRate:     s.BounceRate,
+		SpamReportRate: s.SpamReportRate,
+		DeferralRate:   s.DeferralRate,
+	}
+}
+
+// pbToTime مقدار خالی به زمان صفر (پیش‌فرض سرویس) تبدیل می‌شود
+func pbToTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// optionalTimeToPb زمان صفر (مثلاً جریان تمام نشده) به جای 1970 خالی برمی‌گردد
+func optionalTimeToPb(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,141 @@
+package grpc
+
+import (
+	"context"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	pb "github.com/ehsanshah/campaign-services/src/pkg/pb/camp/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const defaultDeadLetterPageSize = 50
+
+type DeadLetterHandler struct {
+	pb.UnimplementedDeadLetterServiceServer
+	service port.IDeadLetterService
+}
+
+func NewDeadLetterHandler(service port.IDeadLetterService) *DeadLetterHandler {
+	return &DeadLetterHandler{service: service}
+}
+
+func (h *DeadLetterHandler) ListDeadLetters(ctx context.Context, req *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultDeadLetterPageSize
+	}
+	items, total, err := h.service.ListDeadLetters(ctx, req.AccountId, deadLetterFilter(req.Filter), limit, int(req.Offset))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list dead letters: %v", err)
+	}
+
+	resp := &pb.ListDeadLettersResponse{Total: int32(total)}
+	for _, d := range items {
+		resp.DeadLetters = append(resp.DeadLetters, deadLetterToProto(d))
+	}
+	return resp, nil
+}
+
+func (h *DeadLetterHandler) GetDeadLetter(ctx context.Context, req *pb.GetDeadLetterRequest) (*pb.DeadLetterResponse, error) {
+	d, err := h.service.GetDeadLetter(ctx, req.AccountId, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get dead letter: %v", err)
+	}
+	return &pb.DeadLetterResponse{DeadLetter: deadLetterToProto(d)}, nil
+}
+
+func (h *DeadLetterHandler) SummarizeDeadLetters(ctx context.Context, req *pb.SummarizeDeadLettersRequest) (*pb.SummarizeDeadLettersResponse, error) {
+	summaries, err := h.service.SummarizeDeadLetters(ctx, req.AccountId, req.CampaignId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to summarize dead letters: %v", err)
+	}
+
+	resp := &pb.SummarizeDeadLettersResponse{}
+	for _, s := range summaries {
+		resp.Summaries = append(resp.Summaries, &pb.DeadLetterSummary{
+			ErrorClass: s.ErrorClass,
+			Count:      int32(s.Count),
+			LastDeadAt: optionalTimeToPb(s.LastDeadAt),
+		})
+	}
+	return resp, nil
+}
+
+func (h *DeadLetterHandler) ReplayDeadLetter(ctx context.Context, req *pb.ReplayDeadLetterRequest) (*pb.ReplayDeadLetterResponse, error) {
+	batch, err := h.service.ReplayDeadLetter(ctx, req.AccountId, req.Id, deadLetterEdit(req.Edit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to replay dead letter: %v", err)
+	}
+	return &pb.ReplayDeadLetterResponse{BatchId: batch.ID.Hex(), ItemCount: int32(batch.ItemCount)}, nil
+}
+
+func (h *DeadLetterHandler) ReplayDeadLetters(ctx context.Context, req *pb.ReplayDeadLettersRequest) (*pb.ReplayDeadLettersResponse, error) {
+	res, err := h.service.ReplayDeadLetters(ctx, req.AccountId, req.Ids, deadLetterFilter(req.Filter), deadLetterEdit(req.Edit), int(req.Max))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to replay dead letters: %v", err)
+	}
+	return &pb.ReplayDeadLettersResponse{Replayed: int32(res.Replayed), BatchIds: res.BatchIDs}, nil
+}
+
+func (h *DeadLetterHandler) PurgeDeadLetters(ctx context.Context, req *pb.PurgeDeadLettersRequest) (*pb.PurgeDeadLettersResponse, error) {
+	n, err := h.service.PurgeDeadLetters(ctx, req.AccountId, deadLetterFilter(req.Filter), pbToTime(req.Before))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge dead letters: %v", err)
+	}
+	return &pb.PurgeDeadLettersResponse{Purged: int32(n)}, nil
+}
+
+func deadLetterFilter(f *pb.DeadLetterFilter) domain.DeadLetterFilter {
+	if f == nil {
+		return domain.DeadLetterFilter{}
+	}
+	return domain.DeadLetterFilter{CampaignID: f.CampaignId, ErrorClass: f.ErrorClass, Status: f.Status, Email: f.Email}
+}
+
+// deadLetterEdit مقدار null در RecipientData به nil (حذف کلید) تبدیل می‌شود
+func deadLetterEdit(e *pb.DeadLetterEdit) domain.DeadLetterEdit {
+	if e == nil {
+		return domain.DeadLetterEdit{}
+	}
+	return domain.DeadLetterEdit{RecipientEmail: e.RecipientEmail, RecipientData: e.RecipientData.AsMap(), Priority: int(e.Priority)}
+}
+
+func deadLetterToProto(d *models.DeadLetter) *pb.DeadLetter {
+	data, _ := structpb.NewStruct(d.Payload.RecipientData)
+	out := &pb.DeadLetter{
+		Id:             d.ID.Hex(),
+		AccountId:      d.AccountID,
+		CampaignId:     d.CampaignID.Hex(),
+		BatchId:        d.BatchID.Hex(),
+		ItemId:         d.ItemID.Hex(),
+		RecipientEmail: d.RecipientEmail,
+		ErrorClass:     d.ErrorClass,
+		LastError:      d.LastError,
+		Attempts:       int32(d.Attempts),
+		Status:         string(d.Status),
+		DeadAt:         optionalTimeToPb(d.DeadAt),
+		ReplayedAt:     optionalTimeToPb(d.ReplayedAt),
+		RecipientData:  data,
+		Priority:       int32(d.Payload.Priority),
+	}
+	if !d.ReplayBatchID.IsZero() {
+		out.ReplayBatchId = d.ReplayBatchID.Hex()
+		out.ReplayItemId = d.ReplayItemID.Hex()
+	}
+	if d.CampaignID.IsZero() {
+		out.CampaignId = d.Payload.CampaignRef
+	}
+	for _, a := range d.Payload.ErrorHistory {
+		out.ErrorHistory = append(out.ErrorHistory, &pb.DeadLetterAttempt{
+			Attempt:  int32(a.Attempt),
+			Error:    a.Error,
+			WorkerId: a.WorkerID,
+			At:       optionalTimeToPb(a.At),
+		})
+	}
+	return out
+}
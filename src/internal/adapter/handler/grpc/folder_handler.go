@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	pb "github.com/ehsanshah/campaign-services/src/pkg/pb/camp/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type FolderHandler struct {
+	pb.UnimplementedFolderServiceServer
+	service port.IFolderService
+}
+
+func NewFolderHandler(service port.IFolderService) *FolderHandler {
+	return &FolderHandler{service: service}
+}
+
+func (h *FolderHandler) CreateFolder(ctx context.Context, req *pb.CreateFolderRequest) (*pb.FolderResponse, error) {
+	parentID, err := objectID("parent_id", req.ParentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	f, err := h.service.CreateFolder(ctx, &models.MessageFolder{
+		AccountID:   req.AccountId,
+		Name:        req.Name,
+		Description: req.Description,
+		ParentID:    parentID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create folder: %v", err)
+	}
+	return &pb.FolderResponse{Folder: folderToProto(f)}, nil
+}
+
+func (h *FolderHandler) UpdateFolder(ctx context.Context, req *pb.UpdateFolderRequest) (*pb.FolderResponse, error) {
+	f, err := h.service.UpdateFolder(ctx, req.AccountId, req.FolderId, domain.FolderUpdate{
+		Name:        optionalString(req.Name),
+		Description: optionalString(req.Description),
+		ParentID:    optionalString(req.ParentId),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update folder: %v", err)
+	}
+	return &pb.FolderResponse{Folder: folderToProto(f)}, nil
+}
+
+func (h *FolderHandler) GetFolderTree(ctx context.Context, req *pb.GetFolderTreeRequest) (*pb.FolderTreeResponse, error) {
+	roots, err := h.service.GetFolderTree(ctx, req.AccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get folder tree: %v", err)
+	}
+
+	resp := &pb.FolderTreeResponse{}
+	for _, n := range roots {
+		resp.Roots = append(resp.Roots, folderNodeToProto(n))
+	}
+	return resp, nil
+}
+
+func (h *FolderHandler) DeleteFolder(ctx context.Context, req *pb.DeleteFolderRequest) (*pb.DeleteFolderResponse, error) {
+	if err := h.service.DeleteFolder(ctx, req.AccountId, req.FolderId, req.Cascade); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete folder: %v", err)
+	}
+	return &pb.DeleteFolderResponse{Success: true}, nil
+}
+
+func (h *FolderHandler) MoveTemplate(ctx context.Context, req *pb.MoveTemplateRequest) (*pb.MoveItemResponse, error) {
+	if err := h.service.MoveTemplate(ctx, req.AccountId, req.TemplateId, req.FolderId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to move template: %v", err)
+	}
+	return &pb.MoveItemResponse{Success: true}, nil
+}
+
+func (h *FolderHandler) MoveMessage(ctx context.Context, req *pb.MoveMessageRequest) (*pb.MoveItemResponse, error) {
+	if err := h.service.MoveMessage(ctx, req.AccountId, req.MessageId, req.FolderId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to move message: %v", err)
+	}
+	return &pb.MoveItemResponse{Success: true}, nil
+}
+
+func (h *FolderHandler) ListFolderContents(ctx context.Context, req *pb.ListFolderContentsRequest) (*pb.FolderContentsResponse, error) {
+	c, err := h.service.ListFolderContents(ctx, req.AccountId, req.FolderId, req.Recursive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list folder contents: %v", err)
+	}
+
+	resp := &pb.FolderContentsResponse{}
+	if c.Folder != nil {
+		resp.Folder = folderToProto(c.Folder)
+	}
+	for i := range c.Folders {
+		resp.Folders = append(resp.Folders, folderToProto(&c.Folders[i]))
+	}
+	for _, t := range c.Templates {
+		resp.Templates = append(resp.Templates, &pb.FolderTemplate{
+			Id:               t.ID,
+			Name:             t.Name,
+			CurrentVersionId: t.CurrentVersionID,
+			DefaultLanguage:  t.DefaultLanguage,
+			FolderId:         t.FolderID,
+			CreatedAt:        optionalTimeToPb(t.CreatedAt),
+			UpdatedAt:        optionalTimeToPb(t.UpdatedAt),
+		})
+	}
+	for _, m := range c.Messages {
+		resp.Messages = append(resp.Messages, &pb.FolderMessage{
+			Id:         m.ID.Hex(),
+			Name:       m.Name,
+			Subject:    m.Subject,
+			TemplateId: m.TemplateID,
+			IsTemplate: m.IsTemplate,
+			Language:   m.Language,
+			FolderId:   hexOrEmpty(m.FolderID),
+			CreatedAt:  optionalTimeToPb(m.CreatedAt),
+			UpdatedAt:  optionalTimeToPb(m.UpdatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// optionalString مقدار تنظیم نشده (nil) یعنی فیلد در FolderUpdate دست نخورد
+func optionalString(v *wrapperspb.StringValue) *string {
+	if v == nil {
+		return nil
+	}
+	s := v.Value
+	return &s
+}
+
+func folderToProto(f *models.MessageFolder) *pb.Folder {
+	return &pb.Folder{
+		Id:          f.ID.Hex(),
+		Name:        f.Name,
+		Description: f.Description,
+		ParentId:    hexOrEmpty(f.ParentID),
+		CreatedAt:   optionalTimeToPb(f.CreatedAt),
+		UpdatedAt:   optionalTimeToPb(f.UpdatedAt),
+	}
+}
+
+func folderNodeToProto(n *models.MessageFolderNode) *pb.FolderNode {
+	out := &pb.FolderNode{Folder: folderToProto(&n.Folder)}
+	for _, c := range n.Children {
+		out.Children = append(out.Children, folderNodeToProto(c))
+	}
+	return out
+}
@@ -0,0 +1,139 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	pb "github.com/ehsanshah/campaign-services/src/pkg/pb/camp/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TemplateHandler struct {
+	pb.UnimplementedITemplateServicesServer
+	service port.ITemplateServices
+}
+
+func NewTemplateHandler(service port.ITemplateServices) *TemplateHandler {
+	return &TemplateHandler{service: service}
+}
+
+func (h *TemplateHandler) CreateTemplate(ctx context.Context, req *pb.CreateTemplateRequest) (*pb.TemplateResponse, error) {
+	if req.InitialVersion == nil {
+		return nil, status.Error(codes.InvalidArgument, "initial_version is required")
+	}
+	v := versionFromProto(req.InitialVersion)
+	t, err := h.service.CreateTemplate(ctx, &domain.Template{AccountID: req.AccountId, Name: req.Name}, v)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create template: %v", err)
+	}
+	return templateToProto(t, v), nil
+}
+
+func (h *TemplateHandler) UpdateTemplate(ctx context.Context, req *pb.UpdateTemplateRequest) (*pb.TemplateResponse, error) {
+	if req.Version == nil {
+		return nil, status.Error(codes.InvalidArgument, "version is required")
+	}
+	v := versionFromProto(req.Version)
+	v.CreatedBy = req.UserId
+	t, err := h.service.UpdateTemplate(ctx, req.AccountId, req.TemplateId, v)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update template: %v", err)
+	}
+	return templateToProto(t, v), nil
+}
+
+func (h *TemplateHandler) ListTemplates(ctx context.Context, req *pb.ListTemplatesRequest) (*pb.ListTemplatesResponse, error) {
+	templates, total, err := h.service.ListTemplates(ctx, req.AccountId, req.Limit, req.Offset)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list templates: %v", err)
+	}
+
+	resp := &pb.ListTemplatesResponse{Total: total}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, templateToProto(t, nil))
+	}
+	return resp, nil
+}
+
+func (h *TemplateHandler) CopyTemplate(ctx context.Context, req *pb.CopyTemplateRequest) (*pb.TemplateResponse, error) {
+	t, err := h.service.CopyTemplate(ctx, req.AccountId, req.SourceTemplateId, req.NewName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to copy template: %v", err)
+	}
+	return templateToProto(t, nil), nil
+}
+
+func (h *TemplateHandler) ImportTemplateFromUrl(ctx context.Context, req *pb.ImportTemplateFromUrlRequest) (*pb.TemplateResponse, error) {
+	t, err := h.service.ImportTemplateFromUrl(ctx, req.AccountId, req.Name, req.Url)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to import template: %v", err)
+	}
+	return templateToProto(t, nil), nil
+}
+
+func (h *TemplateHandler) TestTemplate(ctx context.Context, req *pb.TestTemplateRequest) (*pb.TestTemplateResponse, error) {
+	if err := h.service.TestTemplate(ctx, req.AccountId, req.TemplateId, req.VersionId, req.TestEmail); err != nil {
+		return &pb.TestTemplateResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.TestTemplateResponse{Success: true}, nil
+}
+
+func (h *TemplateHandler) LintTemplate(ctx context.Context, req *pb.LintTemplateRequest) (*pb.TemplateLintResponse, error) {
+	r, err := h.service.LintTemplate(ctx, req.AccountId, req.TemplateId, req.VersionId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to lint template: %v", err)
+	}
+
+	resp := &pb.TemplateLintResponse{
+		VersionId:    r.VersionID,
+		Passed:       r.Passed,
+		ErrorCount:   int32(r.ErrorCount),
+		WarningCount: int32(r.WarningCount),
+		CheckedAt:    optionalTimeToPb(r.CheckedAt),
+	}
+	for _, f := range r.Findings {
+		resp.Findings = append(resp.Findings, &pb.TemplateLintFinding{
+			Rule:     f.Rule,
+			Severity: f.Severity,
+			Message:  f.Message,
+			Location: f.Location,
+		})
+	}
+	return resp, nil
+}
+
+func versionFromProto(c *pb.TemplateVersionContent) *domain.TemplateVersion {
+	return &domain.TemplateVersion{
+		Subject:      c.Subject,
+		HTMLContent:  c.HtmlContent,
+		PlainText:    c.PlainText,
+		Language:     c.Language,
+		Tags:         c.Tags,
+		Metadata:     c.Metadata,
+		VersionLabel: c.VersionLabel,
+	}
+}
+
+// templateToProto قالب را به پیام پاسخ تبدیل می‌کند؛ v (اختیاری) نسخه‌ای است که در همین درخواست ذخیره شده
+func templateToProto(t *domain.Template, v *domain.TemplateVersion) *pb.TemplateResponse {
+	resp := &pb.TemplateResponse{
+		Id:               t.ID,
+		Name:             t.Name,
+		CurrentVersionId: t.CurrentVersionID,
+		CreatedAt:        optionalTimeToPb(t.CreatedAt),
+	}
+	if v != nil {
+		resp.LatestVersion = &pb.TemplateVersionContent{
+			Subject:      v.Subject,
+			HtmlContent:  v.HTMLContent,
+			PlainText:    v.PlainText,
+			Language:     v.Language,
+			Tags:         v.Tags,
+			Metadata:     v.Metadata,
+			VersionLabel: v.VersionLabel,
+		}
+	}
+	return resp
+}
@@ -0,0 +1,143 @@
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/configs"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+type clientIPKey struct{}
+
+// Server سرور HTTP عمومی سرویس (لغو عضویت و رهگیری) روی ServerConfig
+type Server struct {
+	cfg     configs.ServerConfig
+	mux     *http.ServeMux
+	proxies []netip.Prefix // cfg.TrustedProxies
+}
+
+// NewServer مقدار نامعتبر در TrustedProxies خطا است تا IP جعلی در آمار ثبت نشود
+func NewServer(cfg configs.ServerConfig) (*Server, error) {
+	s := &Server{cfg: cfg, mux: http.NewServeMux()}
+	for _, p := range cfg.TrustedProxies {
+		prefix, err := netip.ParsePrefix(p)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(p)
+			if addrErr != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		s.proxies = append(s.proxies, prefix.Masked())
+	}
+	return s, nil
+}
+
+// Handle ثبت مسیر با الگوهای http.ServeMux (مثل "POST /unsubscribe/{token}")
+func (s *Server) Handle(pattern string, h http.Handler) {
+	s.mux.Handle(pattern, h)
+}
+
+// Run تا لغو ctx سرور را اجرا می‌کند؛ اگر CertFile و KeyFile تنظیم شده باشند TLS فعال است
+func (s *Server) Run(ctx context.Context) error {
+	srv := &http.Server{
+		Addr:              net.JoinHostPort(s.cfg.Address, s.cfg.Port),
+		Handler:           s.withClientIP(s.mux),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Printf("🚀 HTTP server is running on %s", srv.Addr)
+		if s.cfg.CertFile != "" && s.cfg.KeyFile != "" {
+			errCh <- srv.ListenAndServeTLS(s.cfg.CertFile, s.cfg.KeyFile)
+		} else {
+			errCh <- srv.ListenAndServe()
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		sctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(sctx); err != nil {
+			return err
+		}
+		if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return ctx.Err()
+	}
+}
+
+// withClientIP آدرس گیرنده را یک بار برای هر درخواست تعیین و در context قرار می‌دهد (clientIP)
+func (s *Server) withClientIP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), clientIPKey{}, s.resolveClientIP(r))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// resolveClientIP آدرس اتصال؛ اگر از پراکسی مورد اعتماد باشد X-Forwarded-For از راست خوانده و اولین آدرسی که
+// پراکسی مورد اعتماد نیست برگردانده می‌شود (آدرس‌های سمت چپ را خود کلاینت می‌تواند جعل کند)
+func (s *Server) resolveClientIP(r *http.Request) string {
+	ip := remoteIP(r)
+	if !s.trusted(ip) {
+		return ip
+	}
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		ip = hop
+		if !s.trusted(hop) {
+			break
+		}
+	}
+	return ip
+}
+
+func (s *Server) trusted(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range s.proxies {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP آدرس تعیین شده توسط Server یا در نبود آن آدرس اتصال
+func clientIP(r *http.Request) string {
+	if ip, ok := r.Context().Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+	return remoteIP(r)
+}
+
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,64 @@
+package httpserver
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+)
+
+// GIF شفاف ۱×۱
+var trackingPixel = []byte{
+	0x47, 0x49, 0x46, 0x38, 0x39, 0x61, 0x01, 0x00, 0x01, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xff, 0x21, 0xf9, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x00, 0x00, 0x00, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x00, 0x02, 0x02, 0x44, 0x01, 0x00, 0x3b,
+}
+
+type TrackingHandler struct {
+	service port.ITrackingService
+}
+
+func NewTrackingHandler(service port.ITrackingService) *TrackingHandler {
+	return &TrackingHandler{service: service}
+}
+
+// Register مسیرهای پیکسل و لینک؛ الگوی GET درخواست‌های HEAD را هم شامل می‌شود
+func (h *TrackingHandler) Register(s *Server) {
+	s.Handle("GET "+domain.TrackOpenPath+"{token}", http.HandlerFunc(h.open))
+	s.Handle("GET "+domain.TrackClickPath+"{token}", http.HandlerFunc(h.click))
+}
+
+// open پیکسل در هر حالت برگردانده می‌شود تا خطای ثبت در ایمیل گیرنده دیده نشود
+func (h *TrackingHandler) open(w http.ResponseWriter, r *http.Request) {
+	if err := h.service.RecordOpen(r.Context(), trackingRequest(r)); err != nil && !errors.Is(err, port.ErrInvalidToken) {
+		log.Printf("⚠️ tracking open: %v", err)
+	}
+	w.Header().Set("Content-Type", "image/gif")
+	w.Header().Set("Cache-Control", "no-store, no-cache, must-revalidate, max-age=0")
+	w.Header().Set("Pragma", "no-cache")
+	_, _ = w.Write(trackingPixel)
+}
+
+func (h *TrackingHandler) click(w http.ResponseWriter, r *http.Request) {
+	target, err := h.service.RecordClick(r.Context(), trackingRequest(r))
+	if errors.Is(err, port.ErrInvalidToken) {
+		http.Error(w, "invalid link", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		log.Printf("⚠️ tracking click: %v", err)
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	http.Redirect(w, r, target, http.StatusFound)
+}
+
+func trackingRequest(r *http.Request) domain.TrackingRequest {
+	return domain.TrackingRequest{
+		Token:     r.PathValue("token"),
+		Method:    r.Method,
+		IPAddress: clientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+}
@@ -0,0 +1,67 @@
+package httpserver
+
+import (
+	"errors"
+	"html/template"
+	"log"
+	"net/http"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+)
+
+// صفحه لغو عضویت؛ GET فقط فرم تأیید را نشان می‌دهد چون اسکنرهای امنیتی لینک‌های ایمیل را باز می‌کنند
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><meta name="viewport" content="width=device-width, initial-scale=1"><title>Unsubscribe</title></head>
+<body style="font-family:sans-serif;max-width:480px;margin:64px auto;text-align:center">
+{{if .Done}}<p>You have been unsubscribed and will no longer receive these emails.</p>
+{{else}}<p>Do you want to stop receiving these emails?</p>
+<form method="post"><button type="submit">Unsubscribe</button></form>{{end}}
+</body></html>`))
+
+type UnsubscribeHandler struct {
+	service port.IUnsubscribeService
+}
+
+func NewUnsubscribeHandler(service port.IUnsubscribeService) *UnsubscribeHandler {
+	return &UnsubscribeHandler{service: service}
+}
+
+// Register مسیرهای GET و POST لغو عضویت
+func (h *UnsubscribeHandler) Register(s *Server) {
+	s.Handle("GET "+domain.UnsubscribePath+"{token}", http.HandlerFunc(h.confirm))
+	s.Handle("POST "+domain.UnsubscribePath+"{token}", http.HandlerFunc(h.unsubscribe))
+}
+
+func (h *UnsubscribeHandler) confirm(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_ = unsubscribePage.Execute(w, map[string]bool{"Done": false})
+}
+
+// unsubscribe درخواست RFC 8058 (بدنه List-Unsubscribe=One-Click) بدون محتوا پاسخ داده می‌شود و فرم صفحه تأیید با صفحه نتیجه
+func (h *UnsubscribeHandler) unsubscribe(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, 4096)
+	oneClick := r.ParseForm() == nil && r.PostForm.Get("List-Unsubscribe") == "One-Click"
+	source := domain.UnsubscribeLink
+	if oneClick {
+		source = domain.UnsubscribeOneClick
+	}
+
+	_, err := h.service.Unsubscribe(r.Context(), r.PathValue("token"), source, clientIP(r), r.UserAgent())
+	switch {
+	case errors.Is(err, port.ErrInvalidToken):
+		http.Error(w, "invalid unsubscribe link", http.StatusBadRequest)
+		return
+	case err != nil:
+		log.Printf("⚠️ unsubscribe: %v", err)
+		http.Error(w, "unsubscribe failed, please try again later", http.StatusInternalServerError)
+		return
+	}
+
+	if oneClick {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_ = unsubscribePage.Execute(w, map[string]bool{"Done": true})
+}
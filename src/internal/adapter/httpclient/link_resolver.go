@@ -0,0 +1,106 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+)
+
+// حداکثر تعداد Redirect که برای هر لینک دنبال می‌شود
+const maxLinkRedirects = 3
+
+var errPrivateAddress = errors.New("address is not public")
+
+// بازه Carrier-grade NAT که netip.Addr.IsPrivate آن را شامل نمی‌شود
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+type linkResolver struct {
+	client *http.Client
+}
+
+// NewLinkResolver پیاده‌سازی ساده ILinkResolver با درخواست HEAD (و GET در صورت عدم پشتیبانی)
+// اتصال فقط به آدرس‌های عمومی برقرار می‌شود: IP مقصد بعد از DNS (و در هر Redirect) بررسی می‌شود تا لینک قالب
+// نتواند سرویس‌های داخلی را از طرف این سرویس فراخوانی کند
+func NewLinkResolver(timeout time.Duration) port.ILinkResolver {
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	dialer := &net.Dialer{Timeout: timeout, Control: publicOnly}
+	transport := &http.Transport{
+		Proxy:                 nil, // پراکسی محیط بررسی IP مقصد را دور می‌زند
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   timeout,
+		ResponseHeaderTimeout: timeout,
+		MaxIdleConnsPerHost:   2,
+	}
+	return &linkResolver{client: &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxLinkRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxLinkRedirects)
+			}
+			return nil
+		},
+	}}
+}
+
+func (r *linkResolver) Resolve(ctx context.Context, link string) error {
+	u, err := url.Parse(link)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+
+	status, err := r.do(ctx, http.MethodHead, link)
+	if err != nil {
+		return err
+	}
+	// بعضی سرورها HEAD را پشتیبانی نمی‌کنند
+	if status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented {
+		if status, err = r.do(ctx, http.MethodGet, link); err != nil {
+			return err
+		}
+	}
+	if status >= 400 {
+		return fmt.Errorf("unexpected status %d", status)
+	}
+	return nil
+}
+
+func (r *linkResolver) do(ctx context.Context, method, url string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+// publicOnly اتصال به loopback، شبکه‌های خصوصی، link-local (از جمله metadata ابری) و آدرس‌های خاص را رد می‌کند
+func publicOnly(network, address string, _ syscall.RawConn) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	ip := ap.Addr().Unmap()
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() || sharedAddressSpace.Contains(ip) {
+		return fmt.Errorf("%w: %s", errPrivateAddress, ip)
+	}
+	return nil
+}
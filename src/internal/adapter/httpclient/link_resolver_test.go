@@ -0,0 +1,32 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLinkResolverRejectsInternalAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	r := NewLinkResolver(time.Second)
+	for _, link := range []string{
+		srv.URL, // 127.0.0.1
+		"http://10.1.2.3/",
+		"http://169.254.169.254/latest/meta-data/",
+		"http://[::1]/",
+		"http://100.64.0.1/",
+	} {
+		if err := r.Resolve(context.Background(), link); !errors.Is(err, errPrivateAddress) {
+			t.Errorf("Resolve(%q) = %v, want errPrivateAddress", link, err)
+		}
+	}
+
+	if err := r.Resolve(context.Background(), "file:///etc/passwd"); err == nil {
+		t.Error("Resolve accepted a file:// link")
+	}
+}
@@ -0,0 +1,346 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const automationColumns = `id, account_id, client_id, name, description, type, is_active, one_time_only,
+	trigger_events, settings, statistics, created_at, updated_at`
+
+const stepColumns = `id, automation_id, step_number, step_type, name, message_id, campaign_id, subject, from_name, from_email,
+	delay_time, delay_unit, conditions, positive_step_id, negative_step_id, split_ratio, split_step_ids, statistics,
+	split_stats, split_winner_step_id`
+
+// تعریف اتوماسیون در email_automations و مراحل آن در automation_steps ذخیره می‌شوند (شناسه‌ها ObjectID به صورت hex)
+type automationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAutomationRepository(db *pgxpool.Pool) port.IAutomationRepository {
+	return &automationRepository{db: db}
+}
+
+func (r *automationRepository) CreateAutomation(ctx context.Context, a *models.EmailAutomation) error {
+	triggers, settings, stats, err := marshalAutomation(a)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `INSERT INTO email_automations (` + automationColumns + `)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+	if _, err := tx.Exec(ctx, query, a.ID.Hex(), a.AccountID, oidToNull(a.ClientID), a.Name, a.Description, string(a.Type),
+		a.IsActive, a.OneTimeOnly, triggers, settings, stats, a.CreatedAt, a.UpdatedAt); err != nil {
+		return err
+	}
+	if err := insertSteps(ctx, tx, a); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *automationRepository) UpdateAutomation(ctx context.Context, a *models.EmailAutomation) error {
+	triggers, settings, stats, err := marshalAutomation(a)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `UPDATE email_automations
+	          SET name = $1, description = $2, type = $3, is_active = $4, one_time_only = $5,
+	              trigger_events = $6, settings = $7, statistics = $8, updated_at = $9
+	          WHERE id = $10 AND account_id = $11`
+	tag, err := tx.Exec(ctx, query, a.Name, a.Description, string(a.Type), a.IsActive, a.OneTimeOnly,
+		triggers, settings, stats, a.UpdatedAt, a.ID.Hex(), a.AccountID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.New("automation not found or access denied")
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM automation_steps WHERE automation_id = $1`, a.ID.Hex()); err != nil {
+		return err
+	}
+	if err := insertSteps(ctx, tx, a); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *automationRepository) GetAutomation(ctx context.Context, accountID string, id primitive.ObjectID) (*models.EmailAutomation, error) {
+	query := `SELECT ` + automationColumns + ` FROM email_automations WHERE id = $1 AND account_id = $2`
+	a, err := scanAutomation(r.db.QueryRow(ctx, query, id.Hex(), accountID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.New("automation not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadSteps(ctx, []*models.EmailAutomation{a}); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (r *automationRepository) ListAutomations(ctx context.Context, accountID string, limit, offset int) ([]*models.EmailAutomation, int, error) {
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM email_automations WHERE account_id = $1`, accountID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT ` + automationColumns + ` FROM email_automations WHERE account_id = $1
+	          ORDER BY created_at DESC LIMIT $2 OFFSET $3`
+	list, err := r.queryAutomations(ctx, query, accountID, limit, offset)
+	return list, total, err
+}
+
+func (r *automationRepository) ListActiveByTrigger(ctx context.Context, accountID, eventType string) ([]*models.EmailAutomation, error) {
+	query := `SELECT ` + automationColumns + ` FROM email_automations
+	          WHERE account_id = $1 AND is_active AND trigger_events @> jsonb_build_array(jsonb_build_object('event_type', $2::text))`
+	return r.queryAutomations(ctx, query, accountID, eventType)
+}
+
+func (r *automationRepository) ListActiveByType(ctx context.Context, types ...models.AutomationType) ([]*models.EmailAutomation, error) {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = string(t)
+	}
+	query := `SELECT ` + automationColumns + ` FROM email_automations WHERE is_active AND type = ANY($1)`
+	return r.queryAutomations(ctx, query, names)
+}
+
+func (r *automationRepository) SetActive(ctx context.Context, accountID string, id primitive.ObjectID, active bool) error {
+	tag, err := r.db.Exec(ctx, `UPDATE email_automations SET is_active = $1, updated_at = NOW() WHERE id = $2 AND account_id = $3`,
+		active, id.Hex(), accountID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.New("automation not found or access denied")
+	}
+	return nil
+}
+
+func (r *automationRepository) DeleteAutomation(ctx context.Context, accountID string, id primitive.ObjectID) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM email_automations WHERE id = $1 AND account_id = $2`, id.Hex(), accountID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.New("automation not found or access denied")
+	}
+	return nil
+}
+
+func (r *automationRepository) IsCampaignLinked(ctx context.Context, accountID, campaignID string) (bool, error) {
+	var linked bool
+	query := `SELECT EXISTS (
+	              SELECT 1 FROM automation_steps s JOIN email_automations a ON a.id = s.automation_id
+	              WHERE a.account_id = $1 AND s.campaign_id = $2
+	          )`
+	err := r.db.QueryRow(ctx, query, accountID, campaignID).Scan(&linked)
+	return linked, err
+}
+
+func (r *automationRepository) SaveSplitStats(ctx context.Context, automationID, stepID primitive.ObjectID, stats []models.StepStats) error {
+	data, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.Exec(ctx, `UPDATE automation_steps SET split_stats = $1 WHERE id = $2 AND automation_id = $3`,
+		data, stepID.Hex(), automationID.Hex())
+	return err
+}
+
+func (r *automationRepository) SetSplitWinner(ctx context.Context, automationID, stepID, winnerStepID primitive.ObjectID) error {
+	tag, err := r.db.Exec(ctx, `UPDATE automation_steps SET split_winner_step_id = $1 WHERE id = $2 AND automation_id = $3`,
+		oidToNull(winnerStepID), stepID.Hex(), automationID.Hex())
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.New("automation step not found")
+	}
+	return nil
+}
+
+// ---------------------------------------------------------
+// توابع کمکی
+// ---------------------------------------------------------
+
+func (r *automationRepository) queryAutomations(ctx context.Context, query string, args ...any) ([]*models.EmailAutomation, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	var list []*models.EmailAutomation
+	for rows.Next() {
+		a, err := scanAutomation(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		list = append(list, a)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadSteps(ctx, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// loadSteps مراحل همه اتوماسیون‌ها را با یک کوئری می‌خواند
+func (r *automationRepository) loadSteps(ctx context.Context, list []*models.EmailAutomation) error {
+	if len(list) == 0 {
+		return nil
+	}
+	byID := make(map[string]*models.EmailAutomation, len(list))
+	ids := make([]string, 0, len(list))
+	for _, a := range list {
+		byID[a.ID.Hex()] = a
+		ids = append(ids, a.ID.Hex())
+	}
+
+	rows, err := r.db.Query(ctx, `SELECT `+stepColumns+` FROM automation_steps WHERE automation_id = ANY($1) ORDER BY step_number`, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var st models.AutomationStep
+		var id, automationID string
+		var messageID, positiveID, negativeID *string
+		var splitIDs []string
+		var conditions, stats, splitStats []byte
+		var winnerID *string
+		if err := rows.Scan(&id, &automationID, &st.StepNumber, &st.StepType, &st.Name, &messageID, &st.CampaignID,
+			&st.Subject, &st.FromName, &st.FromEmail, &st.DelayTime, &st.DelayUnit, &conditions,
+			&positiveID, &negativeID, &st.SplitRatio, &splitIDs, &stats, &splitStats, &winnerID); err != nil {
+			return err
+		}
+		st.ID, _ = primitive.ObjectIDFromHex(id)
+		st.MessageID = nullToOID(messageID)
+		st.PositiveStepID = nullToOID(positiveID)
+		st.NegativeStepID = nullToOID(negativeID)
+		st.SplitWinnerStepID = nullToOID(winnerID)
+		for _, hex := range splitIDs {
+			sid, err := primitive.ObjectIDFromHex(hex)
+			if err != nil {
+				return fmt.Errorf("invalid split step id %q: %w", hex, err)
+			}
+			st.SplitStepIDs = append(st.SplitStepIDs, sid)
+		}
+		if len(conditions) > 0 {
+			if err := json.Unmarshal(conditions, &st.Conditions); err != nil {
+				return err
+			}
+		}
+		if len(stats) > 0 {
+			if err := json.Unmarshal(stats, &st.Statistics); err != nil {
+				return err
+			}
+		}
+		if len(splitStats) > 0 {
+			if err := json.Unmarshal(splitStats, &st.SplitStats); err != nil {
+				return err
+			}
+		}
+		if a, ok := byID[automationID]; ok {
+			a.Steps = append(a.Steps, st)
+		}
+	}
+	return rows.Err()
+}
+
+func insertSteps(ctx context.Context, tx pgx.Tx, a *models.EmailAutomation) error {
+	query := `INSERT INTO automation_steps (` + stepColumns + `)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)`
+	for _, st := range a.Steps {
+		conditions, err := json.Marshal(st.Conditions)
+		if err != nil {
+			return err
+		}
+		stats, err := json.Marshal(st.Statistics)
+		if err != nil {
+			return err
+		}
+		splitStats, err := json.Marshal(st.SplitStats)
+		if err != nil {
+			return err
+		}
+		splitIDs := make([]string, 0, len(st.SplitStepIDs))
+		for _, id := range st.SplitStepIDs {
+			splitIDs = append(splitIDs, id.Hex())
+		}
+		if _, err := tx.Exec(ctx, query, st.ID.Hex(), a.ID.Hex(), st.StepNumber, st.StepType, st.Name,
+			oidToNull(st.MessageID), st.CampaignID, st.Subject, st.FromName, st.FromEmail, st.DelayTime, st.DelayUnit, conditions,
+			oidToNull(st.PositiveStepID), oidToNull(st.NegativeStepID), st.SplitRatio, splitIDs, stats,
+			splitStats, oidToNull(st.SplitWinnerStepID)); err != nil {
+			return fmt.Errorf("failed to save step %d: %w", st.StepNumber, err)
+		}
+	}
+	return nil
+}
+
+func marshalAutomation(a *models.EmailAutomation) (triggers, settings, stats []byte, err error) {
+	if triggers, err = json.Marshal(a.TriggerEvents); err != nil {
+		return
+	}
+	if settings, err = json.Marshal(a.Settings); err != nil {
+		return
+	}
+	stats, err = json.Marshal(a.Statistics)
+	return
+}
+
+func scanAutomation(row pgx.Row) (*models.EmailAutomation, error) {
+	var a models.EmailAutomation
+	var id, automationType string
+	var clientID *string
+	var triggers, settings, stats []byte
+	if err := row.Scan(&id, &a.AccountID, &clientID, &a.Name, &a.Description, &automationType, &a.IsActive, &a.OneTimeOnly,
+		&triggers, &settings, &stats, &a.CreatedAt, &a.UpdatedAt); err != nil {
+		return nil, err
+	}
+	a.ID, _ = primitive.ObjectIDFromHex(id)
+	a.ClientID = nullToOID(clientID)
+	a.Type = models.AutomationType(automationType)
+
+	for _, part := range []struct {
+		data []byte
+		dest any
+	}{{triggers, &a.TriggerEvents}, {settings, &a.Settings}, {stats, &a.Statistics}} {
+		if len(part.data) == 0 {
+			continue
+		}
+		if err := json.Unmarshal(part.data, part.dest); err != nil {
+			return nil, err
+		}
+	}
+	return &a, nil
+}
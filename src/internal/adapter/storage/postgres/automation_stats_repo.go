@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// شمارنده‌ها روزانه (UTC) در automation_stats_daily جمع می‌شوند؛ step_id خالی ردیف سطح اتوماسیون است
+type automationStatsRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAutomationStatsRepository(db *pgxpool.Pool) port.IAutomationStatsRepository {
+	return &automationStatsRepository{db: db}
+}
+
+const incrementCountersQuery = `INSERT INTO automation_stats_daily (automation_id, step_id, day, entries, completions, drop_offs, exited,
+	    emails_sent, emails_opened, emails_clicked, conversions, revenue)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	ON CONFLICT (automation_id, step_id, day) DO UPDATE SET
+	    entries = automation_stats_daily.entries + EXCLUDED.entries,
+	    completions = automation_stats_daily.completions + EXCLUDED.completions,
+	    drop_offs = automation_stats_daily.drop_offs + EXCLUDED.drop_offs,
+	    exited = automation_stats_daily.exited + EXCLUDED.exited,
+	    emails_sent = automation_stats_daily.emails_sent + EXCLUDED.emails_sent,
+	    emails_opened = automation_stats_daily.emails_opened + EXCLUDED.emails_opened,
+	    emails_clicked = automation_stats_daily.emails_clicked + EXCLUDED.emails_clicked,
+	    conversions = automation_stats_daily.conversions + EXCLUDED.conversions,
+	    revenue = automation_stats_daily.revenue + EXCLUDED.revenue`
+
+func (r *automationStatsRepository) IncrementCounters(ctx context.Context, counters []domain.AutomationCounters) error {
+	batch := &pgx.Batch{}
+	for _, c := range counters {
+		if c.IsZero() {
+			continue
+		}
+		queueIncrement(batch, c)
+	}
+	if batch.Len() == 0 {
+		return nil
+	}
+	return r.db.SendBatch(ctx, batch).Close()
+}
+
+func (r *automationStatsRepository) SumCounters(ctx context.Context, automationID string, from, to time.Time) ([]domain.AutomationCounters, error) {
+	query := `SELECT step_id, SUM(entries), SUM(completions), SUM(drop_offs), SUM(exited), SUM(emails_sent),
+	                 SUM(emails_opened), SUM(emails_clicked), SUM(conversions), SUM(revenue)
+	          FROM automation_stats_daily
+	          WHERE automation_id = $1 AND ($2::date IS NULL OR day >= $2) AND ($3::date IS NULL OR day < $3)
+	          GROUP BY step_id`
+	rows, err := r.db.Query(ctx, query, automationID, dayOrNull(from), dayOrNull(to))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []domain.AutomationCounters
+	for rows.Next() {
+		c := domain.AutomationCounters{AutomationID: automationID}
+		if err := rows.Scan(&c.StepID, &c.Entries, &c.Completions, &c.DropOffs, &c.Exited, &c.EmailsSent,
+			&c.EmailsOpened, &c.EmailsClicked, &c.Conversions, &c.Revenue); err != nil {
+			return nil, err
+		}
+		list = append(list, c)
+	}
+	return list, rows.Err()
+}
+
+// RecordConversion: ثبت سفارش و افزایش درآمد در یک تراکنش تا سفارش تکراری دوباره شمرده نشود
+func (r *automationStatsRepository) RecordConversion(ctx context.Context, c *domain.AutomationConversion) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `INSERT INTO automation_conversions
+	        (account_id, order_id, automation_id, journey_id, step_id, email, revenue, occurred_at)
+	        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	        ON CONFLICT (account_id, order_id) DO NOTHING`,
+		c.AccountID, c.OrderID, c.AutomationID, c.JourneyID, c.StepID, c.Email, c.Revenue, c.OccurredAt)
+	if err != nil {
+		return false, err
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	batch := &pgx.Batch{}
+	queueIncrement(batch, domain.AutomationCounters{AutomationID: c.AutomationID, Day: c.OccurredAt, Conversions: 1, Revenue: c.Revenue})
+	if c.StepID != "" {
+		queueIncrement(batch, domain.AutomationCounters{AutomationID: c.AutomationID, StepID: c.StepID, Day: c.OccurredAt, Conversions: 1, Revenue: c.Revenue})
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return false, err
+	}
+	return true, tx.Commit(ctx)
+}
+
+func queueIncrement(batch *pgx.Batch, c domain.AutomationCounters) {
+	batch.Queue(incrementCountersQuery, c.AutomationID, c.StepID, c.Day.UTC().Format("2006-01-02"),
+		c.Entries, c.Completions, c.DropOffs, c.Exited, c.EmailsSent, c.EmailsOpened, c.EmailsClicked, c.Conversions, c.Revenue)
+}
+
+// dayOrNull تاریخ UTC برای ستون DATE (زمان صفر یعنی بدون محدودیت)
+func dayOrNull(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	day := t.UTC().Format("2006-01-02")
+	return &day
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
@@ -42,6 +43,7 @@ type CampaignSchema struct {
 	CreatedAt        time.Time    `db:"created_at"`
 	UpdatedAt        time.Time    `db:"updated_at"`
 	ScheduledFor     sql.NullTime `db:"scheduled_for"`
+	DispatchAt       sql.NullTime `db:"dispatch_at"` // Campaign.DispatchAt؛ فقط نوشته می‌شود
 	StartedAt        sql.NullTime `db:"started_at"`
 	FinishedAt       sql.NullTime `db:"finished_at"`
 	StoppedAt        sql.NullTime `db:"stopped_at"`
@@ -79,13 +81,13 @@ func (r *campaignRepository) Create(ctx context.Context, c *domain.Campaign) err
 		INSERT INTO campaigns (
 			id, account_id, name, status, type_for_humans,
 			recipients, options, stats, filters, extra_fields,
-			created_at, updated_at, scheduled_for, started_at,
+			created_at, updated_at, scheduled_for, dispatch_at, started_at,
 			is_stopped, is_currently_sending_out, can_be_scheduled, has_winner,
 			email_ids, default_email_id, warnings, used_in_automations
 		) VALUES (
 			:id, :account_id, :name, :status, :type_for_humans,
 			:recipients, :options, :stats, :filters, :extra_fields,
-			:created_at, :updated_at, :scheduled_for, :started_at,
+			:created_at, :updated_at, :scheduled_for, :dispatch_at, :started_at,
 			:is_stopped, :is_currently_sending_out, :can_be_scheduled, :has_winner,
 			:email_ids, :default_email_id, :warnings, :used_in_automations
 		)`
@@ -103,13 +105,16 @@ func (r *campaignRepository) Update(ctx context.Context, c *domain.Campaign) err
 	}
 
 	// آپدیت کامل (معمولاً بهتر است Partial Update داشته باشیم ولی اینجا کامل می‌نویسیم)
+	// stats فقط توسط ApplyEvents نوشته می‌شود تا ذخیره کمپین (مثلاً لغو در حین ارسال) شمارنده‌ها را بازنویسی نکند
 	query := `
 		UPDATE campaigns SET
 			name=:name, status=:status, recipients=:recipients, options=:options,
-			stats=:stats, filters=:filters, updated_at=:updated_at,
-			scheduled_for=:scheduled_for, started_at=:started_at, 
+			filters=:filters, updated_at=:updated_at,
+			scheduled_for=:scheduled_for, dispatch_at=:dispatch_at, started_at=:started_at, 
 			finished_at=:finished_at, stopped_at=:stopped_at,
-			is_stopped=:is_stopped, email_ids=:email_ids
+			is_stopped=:is_stopped, is_currently_sending_out=:is_currently_sending_out,
+			email_ids=:email_ids, warnings=:warnings,
+			winner_sending_time_for_humans=:winner_sending_time_for_humans
 		WHERE id=:id AND account_id=:account_id`
 
 	result, err := r.db.NamedExecContext(ctx, query, schema)
@@ -147,17 +152,162 @@ func (r *campaignRepository) List(ctx context.Context, accountID string, limit i
 	if err != nil {
 		return nil, err
 	}
+	return schemasToDomain(schemas), nil
+}
 
-	// تبدیل لیست اسکیما به لیست دامین
-	var campaigns []*domain.Campaign
-	for _, s := range schemas {
-		d, err := toDomain(&s)
-		if err != nil {
-			continue // یا هندل کردن خطا
+// ClaimDue با FOR UPDATE SKIP LOCKED هر کمپین فقط توسط یک نمونه سرویس برداشته می‌شود؛
+// سررسید بر اساس dispatch_at است (کمپین local_time تا ۱۴ ساعت زودتر از scheduled_for)
+func (r *campaignRepository) ClaimDue(ctx context.Context, now time.Time, limit int) ([]*domain.Campaign, error) {
+	var schemas []CampaignSchema
+	query := `
+		UPDATE campaigns SET status=$3, started_at=$1, is_currently_sending_out=FALSE, updated_at=NOW()
+		WHERE id IN (
+			SELECT id FROM campaigns
+			WHERE status=$4 AND COALESCE(dispatch_at, scheduled_for) <= $1 AND NOT is_stopped
+			ORDER BY COALESCE(dispatch_at, scheduled_for) LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`
+
+	err := r.db.SelectContext(ctx, &schemas, query, now, limit, domain.StatusProcessing, domain.StatusScheduled)
+	if err != nil {
+		return nil, err
+	}
+	return schemasToDomain(schemas), nil
+}
+
+func (r *campaignRepository) ListByStatus(ctx context.Context, status string, limit int) ([]*domain.Campaign, error) {
+	var schemas []CampaignSchema
+	query := `SELECT * FROM campaigns WHERE status=$1 ORDER BY started_at LIMIT $2`
+
+	err := r.db.SelectContext(ctx, &schemas, query, status, limit)
+	if err != nil {
+		return nil, err
+	}
+	return schemasToDomain(schemas), nil
+}
+
+// ApplyEvents رویدادها در campaign_recipient_events (یک ردیف برای هر گیرنده و نوع رویداد) ثبت می‌شوند؛
+// ردیف جدید یعنی اولین رویداد این نوع برای گیرنده و در شمارنده‌های یکتای Stats حساب می‌شود.
+// شناسه رویدادها در همین تراکنش در processed_email_events ثبت و رویدادی که قبلاً شمرده شده کنار گذاشته می‌شود.
+func (r *campaignRepository) ApplyEvents(ctx context.Context, accountID, campaignID string, events []domain.EmailEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var statsJSON []byte
+	err = tx.GetContext(ctx, &statsJSON, `SELECT stats FROM campaigns WHERE id=$1 AND account_id=$2 FOR UPDATE`, campaignID, accountID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var stats domain.CampaignStats
+	if len(statsJSON) > 0 {
+		if err := json.Unmarshal(statsJSON, &stats); err != nil {
+			return err
 		}
-		campaigns = append(campaigns, d)
 	}
-	return campaigns, nil
+
+	var ids []string
+	for _, e := range events {
+		if e.ID != "" {
+			ids = append(ids, e.ID)
+		}
+	}
+	fresh := make(map[string]bool, len(ids))
+	if len(ids) > 0 {
+		var inserted []string
+		query := `INSERT INTO processed_email_events (event_id, scope)
+		          SELECT DISTINCT unnest($1::text[]), $2::text
+		          ON CONFLICT DO NOTHING RETURNING event_id`
+		if err := tx.SelectContext(ctx, &inserted, query, pq.Array(ids), eventScopeCampaignStats); err != nil {
+			return err
+		}
+		for _, id := range inserted {
+			fresh[id] = true
+		}
+	}
+
+	type recipientEvent struct {
+		eventType, email string
+	}
+	counts := make(map[recipientEvent]int)
+	lastAt := make(map[recipientEvent]time.Time)
+	for _, e := range events {
+		if e.ID != "" {
+			if !fresh[e.ID] {
+				continue
+			}
+			delete(fresh, e.ID) // تکرار در همین دسته هم یک بار شمرده می‌شود
+		}
+		k := recipientEvent{eventType: e.Type, email: strings.ToLower(strings.TrimSpace(e.Email))}
+		if k.email == "" {
+			continue
+		}
+		counts[k]++
+		if at := e.At; at.IsZero() || at.After(lastAt[k]) {
+			if at.IsZero() {
+				at = time.Now()
+			}
+			lastAt[k] = at
+		}
+	}
+	if len(counts) == 0 {
+		return tx.Commit()
+	}
+
+	var types, emails, times []string
+	var ns []int64
+	for k, n := range counts {
+		types = append(types, k.eventType)
+		emails = append(emails, k.email)
+		ns = append(ns, int64(n))
+		times = append(times, lastAt[k].UTC().Format(time.RFC3339Nano))
+	}
+	var inserted []struct {
+		EventType string `db:"event_type"`
+		Inserted  bool   `db:"inserted"`
+	}
+	query := `
+		INSERT INTO campaign_recipient_events (campaign_id, event_type, email, count, first_at, last_at)
+		SELECT $1, t, e, n, at, at FROM unnest($2::text[], $3::text[], $4::bigint[], $5::timestamptz[]) AS u(t, e, n, at)
+		ON CONFLICT (campaign_id, event_type, email) DO UPDATE SET
+			count = campaign_recipient_events.count + EXCLUDED.count,
+			last_at = GREATEST(campaign_recipient_events.last_at, EXCLUDED.last_at)
+		RETURNING event_type, (xmax = 0) AS inserted`
+	if err := tx.SelectContext(ctx, &inserted, query, campaignID, pq.Array(types), pq.Array(emails), pq.Array(ns), pq.Array(times)); err != nil {
+		return err
+	}
+
+	totals := make(map[string]int64)
+	unique := make(map[string]int64)
+	for k, n := range counts {
+		totals[k.eventType] += int64(n)
+	}
+	for _, row := range inserted {
+		if row.Inserted {
+			unique[row.EventType]++
+		}
+	}
+	for t, n := range totals {
+		stats.Apply(t, n, unique[t])
+	}
+
+	statsJSON, err = json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE campaigns SET stats=$1, updated_at=NOW() WHERE id=$2`, statsJSON, campaignID); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 func (r *campaignRepository) UpdateStatus(ctx context.Context, id string, status string) error {
@@ -166,6 +316,42 @@ func (r *campaignRepository) UpdateStatus(ctx context.Context, id string, status
 	return err
 }
 
+func (r *campaignRepository) SetUsedInAutomations(ctx context.Context, id string, accountID string, used bool) error {
+	query := `UPDATE campaigns SET used_in_automations=$1, updated_at=NOW() WHERE id=$2 AND account_id=$3`
+	res, err := r.db.ExecContext(ctx, query, used, id, accountID)
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return errors.New("campaign not found")
+	}
+	return nil
+}
+
+// MarkSending: فقط کمپینی که هنوز Processing است (مثلاً در این فاصله لغو نشده) تغییر می‌کند
+func (r *campaignRepository) MarkSending(ctx context.Context, id string, accountID string, winnerSendingTime string) error {
+	query := `UPDATE campaigns SET is_currently_sending_out=TRUE, winner_sending_time_for_humans=$1, updated_at=NOW()
+	          WHERE id=$2 AND account_id=$3 AND status=$4`
+	res, err := r.db.ExecContext(ctx, query, winnerSendingTime, id, accountID, domain.StatusProcessing)
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return errors.New("campaign is no longer processing")
+	}
+	return nil
+}
+
+func (r *campaignRepository) FinishDispatch(ctx context.Context, id string, accountID string, status string, finishedAt time.Time, warning string) error {
+	query := `UPDATE campaigns SET status=$1, is_currently_sending_out=FALSE, finished_at=$2, updated_at=NOW(),
+	              warnings=CASE WHEN $3 = '' THEN warnings ELSE array_append(COALESCE(warnings, '{}'), $3) END
+	          WHERE id=$4 AND account_id=$5 AND status=$6`
+	_, err := r.db.ExecContext(ctx, query, status, finishedAt, warning, id, accountID, domain.StatusProcessing)
+	return err
+}
+
 func (r *campaignRepository) Delete(ctx context.Context, id string, accountID string) error {
 	query := `DELETE FROM campaigns WHERE id=$1 AND account_id=$2`
 	res, err := r.db.ExecContext(ctx, query, id, accountID)
@@ -191,53 +377,56 @@ func toSchema(c *domain.Campaign) (*CampaignSchema, error) {
 	extra, _ := json.Marshal(c.ExtraFields)
 
 	return &CampaignSchema{
-		ID:                 c.ID,
-		AccountID:          c.AccountID,
-		Name:               c.Name,
-		Status:             c.Status,
-		TypeForHumans:      c.TypeForHumans,
-		RecipientsJSON:     recipients,
-		OptionsJSON:        options,
-		StatsJSON:          stats,
-		FiltersJSON:        filters,
-		ExtraFieldsJSON:    extra,
-		CreatedAt:          c.CreatedAt,
-		UpdatedAt:          c.UpdatedAt,
-		ScheduledFor:       timeToNull(c.ScheduledFor),
-		StartedAt:          timeToNull(c.StartedAt),
-		FinishedAt:         timeToNull(c.FinishedAt),
-		StoppedAt:          timeToNull(c.StoppedAt),
-		IsStopped:          c.IsStopped,
-		IsCurrentlySending: c.IsCurrentlySending,
-		CanBeScheduled:     c.CanBeScheduled,
-		HasWinner:          c.HasWinner,
-		EmailIDs:           pq.StringArray(c.EmailIDs),
-		Warnings:           pq.StringArray(c.Warnings),
-		UsedInAutomations:  c.UsedInAutomations,
+		ID:                         c.ID,
+		AccountID:                  c.AccountID,
+		Name:                       c.Name,
+		Status:                     c.Status,
+		TypeForHumans:              c.TypeForHumans,
+		RecipientsJSON:             recipients,
+		OptionsJSON:                options,
+		StatsJSON:                  stats,
+		FiltersJSON:                filters,
+		ExtraFieldsJSON:            extra,
+		CreatedAt:                  c.CreatedAt,
+		UpdatedAt:                  c.UpdatedAt,
+		ScheduledFor:               timeToNull(c.ScheduledFor),
+		DispatchAt:                 timeToNull(c.DispatchAt()),
+		StartedAt:                  timeToNull(c.StartedAt),
+		FinishedAt:                 timeToNull(c.FinishedAt),
+		StoppedAt:                  timeToNull(c.StoppedAt),
+		IsStopped:                  c.IsStopped,
+		IsCurrentlySending:         c.IsCurrentlySending,
+		CanBeScheduled:             c.CanBeScheduled,
+		HasWinner:                  c.HasWinner,
+		WinnerSendingTimeForHumans: c.WinnerSendingTimeForHumans,
+		EmailIDs:                   pq.StringArray(c.EmailIDs),
+		Warnings:                   pq.StringArray(c.Warnings),
+		UsedInAutomations:          c.UsedInAutomations,
 		// ... بقیه فیلدها ...
 	}, nil
 }
 
 func toDomain(s *CampaignSchema) (*domain.Campaign, error) {
 	c := &domain.Campaign{
-		ID:                 s.ID,
-		AccountID:          s.AccountID,
-		Name:               s.Name,
-		Status:             s.Status,
-		TypeForHumans:      s.TypeForHumans,
-		CreatedAt:          s.CreatedAt,
-		UpdatedAt:          s.UpdatedAt,
-		ScheduledFor:       nullToTime(s.ScheduledFor),
-		StartedAt:          nullToTime(s.StartedAt),
-		FinishedAt:         nullToTime(s.FinishedAt),
-		StoppedAt:          nullToTime(s.StoppedAt),
-		IsStopped:          s.IsStopped,
-		IsCurrentlySending: s.IsCurrentlySending,
-		CanBeScheduled:     s.CanBeScheduled,
-		HasWinner:          s.HasWinner,
-		EmailIDs:           []string(s.EmailIDs),
-		Warnings:           []string(s.Warnings),
-		UsedInAutomations:  s.UsedInAutomations,
+		ID:                         s.ID,
+		AccountID:                  s.AccountID,
+		Name:                       s.Name,
+		Status:                     s.Status,
+		TypeForHumans:              s.TypeForHumans,
+		CreatedAt:                  s.CreatedAt,
+		UpdatedAt:                  s.UpdatedAt,
+		ScheduledFor:               nullToTime(s.ScheduledFor),
+		StartedAt:                  nullToTime(s.StartedAt),
+		FinishedAt:                 nullToTime(s.FinishedAt),
+		StoppedAt:                  nullToTime(s.StoppedAt),
+		IsStopped:                  s.IsStopped,
+		IsCurrentlySending:         s.IsCurrentlySending,
+		CanBeScheduled:             s.CanBeScheduled,
+		HasWinner:                  s.HasWinner,
+		WinnerSendingTimeForHumans: s.WinnerSendingTimeForHumans,
+		EmailIDs:                   []string(s.EmailIDs),
+		Warnings:                   []string(s.Warnings),
+		UsedInAutomations:          s.UsedInAutomations,
 	}
 
 	// Unmarshal JSONs
@@ -260,6 +449,19 @@ func toDomain(s *CampaignSchema) (*domain.Campaign, error) {
 	return c, nil
 }
 
+// تبدیل لیست اسکیما به لیست دامین
+func schemasToDomain(schemas []CampaignSchema) []*domain.Campaign {
+	var campaigns []*domain.Campaign
+	for _, s := range schemas {
+		d, err := toDomain(&s)
+		if err != nil {
+			continue // یا هندل کردن خطا
+		}
+		campaigns = append(campaigns, d)
+	}
+	return campaigns
+}
+
 // ابزارهای تبدیل زمان به NullTime و برعکس
 func timeToNull(t *time.Time) sql.NullTime {
 	if t == nil {
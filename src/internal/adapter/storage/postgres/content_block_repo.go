@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+)
+
+type contentBlockRepository struct {
+	db *sql.DB
+}
+
+func NewContentBlockRepository(db *sql.DB) *contentBlockRepository {
+	return &contentBlockRepository{db: db}
+}
+
+func (r *contentBlockRepository) SaveBlock(ctx context.Context, b *domain.ContentBlock) error {
+	query := `INSERT INTO content_blocks (id, account_id, name, tag, current_version_id, updated_at)
+	          VALUES (COALESCE(NULLIF($1, '')::uuid, gen_random_uuid()), $2, $3, $4, NULLIF($5, '')::uuid, NOW())
+	          ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, current_version_id = EXCLUDED.current_version_id, updated_at = NOW()
+	          RETURNING id, created_at, updated_at`
+	return r.db.QueryRowContext(ctx, query, b.ID, b.AccountID, b.Name, b.Tag, b.CurrentVersionID).Scan(&b.ID, &b.CreatedAt, &b.UpdatedAt)
+}
+
+func (r *contentBlockRepository) SaveBlockVersion(ctx context.Context, v *domain.ContentBlockVersion) error {
+	query := `INSERT INTO content_block_versions (block_id, version_label, html_content, plain_text)
+	          VALUES ($1, $2, $3, $4) RETURNING id, created_at`
+	return r.db.QueryRowContext(ctx, query, v.BlockID, v.VersionLabel, v.HTMLContent, v.PlainText).Scan(&v.ID, &v.CreatedAt)
+}
+
+func (r *contentBlockRepository) GetBlock(ctx context.Context, accountID, blockID string) (*domain.ContentBlock, *domain.ContentBlockVersion, error) {
+	return r.getBlock(ctx, `b.account_id = $1 AND b.id = $2`, accountID, blockID)
+}
+
+func (r *contentBlockRepository) GetBlockByTag(ctx context.Context, accountID, tag string) (*domain.ContentBlock, *domain.ContentBlockVersion, error) {
+	return r.getBlock(ctx, `b.account_id = $1 AND b.tag = $2`, accountID, tag)
+}
+
+func (r *contentBlockRepository) getBlock(ctx context.Context, where, accountID, key string) (*domain.ContentBlock, *domain.ContentBlockVersion, error) {
+	var b domain.ContentBlock
+	var v domain.ContentBlockVersion
+	query := `SELECT b.id, b.account_id, b.name, b.tag, COALESCE(b.current_version_id::text, ''), b.created_at, b.updated_at,
+	                 COALESCE(bv.version_label, ''), COALESCE(bv.html_content, ''), COALESCE(bv.plain_text, '')
+	          FROM content_blocks b
+	          LEFT JOIN content_block_versions bv ON bv.id = b.current_version_id
+	          WHERE ` + where
+
+	err := r.db.QueryRowContext(ctx, query, accountID, key).Scan(
+		&b.ID, &b.AccountID, &b.Name, &b.Tag, &b.CurrentVersionID, &b.CreatedAt, &b.UpdatedAt,
+		&v.VersionLabel, &v.HTMLContent, &v.PlainText,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, errors.New("content block not found")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	v.ID = b.CurrentVersionID
+	v.BlockID = b.ID
+	return &b, &v, nil
+}
+
+func (r *contentBlockRepository) ListBlocks(ctx context.Context, accountID string) ([]*domain.ContentBlock, error) {
+	query := `SELECT id, account_id, name, tag, COALESCE(current_version_id::text, ''), created_at, updated_at
+	          FROM content_blocks WHERE account_id = $1 ORDER BY name`
+	rows, err := r.db.QueryContext(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var blocks []*domain.ContentBlock
+	for rows.Next() {
+		var b domain.ContentBlock
+		if err := rows.Scan(&b.ID, &b.AccountID, &b.Name, &b.Tag, &b.CurrentVersionID, &b.CreatedAt, &b.UpdatedAt); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, &b)
+	}
+	return blocks, rows.Err()
+}
+
+func (r *contentBlockRepository) DeleteBlock(ctx context.Context, accountID, blockID string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM content_blocks WHERE id = $1 AND account_id = $2`, blockID, accountID)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return errors.New("content block not found or access denied")
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const deadLetterColumns = `id, account_id, campaign_id, batch_id, item_id, recipient_email, error_class, last_error, attempts,
+	payload, status, dead_at, replayed_at, replay_batch_id, replay_item_id`
+
+// رکوردهای صف مرده توسط queueRepository.FailItem درج می‌شوند
+type deadLetterRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewDeadLetterRepository(db *pgxpool.Pool) port.IDeadLetterRepository {
+	return &deadLetterRepository{db: db}
+}
+
+func (r *deadLetterRepository) ListDeadLetters(ctx context.Context, accountID string, filter domain.DeadLetterFilter, limit, offset int) ([]*models.DeadLetter, int, error) {
+	where, args := deadLetterWhere(accountID, filter)
+
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM dead_letters WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT ` + deadLetterColumns + ` FROM dead_letters WHERE ` + where + `
+	          ORDER BY dead_at DESC, id LIMIT $6 OFFSET $7`
+	rows, err := r.db.Query(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var letters []*models.DeadLetter
+	for rows.Next() {
+		d, err := scanDeadLetter(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		letters = append(letters, d)
+	}
+	return letters, total, rows.Err()
+}
+
+func (r *deadLetterRepository) GetDeadLetter(ctx context.Context, accountID string, id primitive.ObjectID) (*models.DeadLetter, error) {
+	query := `SELECT ` + deadLetterColumns + ` FROM dead_letters WHERE id = $1 AND account_id = $2`
+	d, err := scanDeadLetter(r.db.QueryRow(ctx, query, id.Hex(), accountID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.New("dead letter not found")
+	}
+	return d, err
+}
+
+func (r *deadLetterRepository) SummarizeDeadLetters(ctx context.Context, accountID, campaignID string) ([]domain.DeadLetterSummary, error) {
+	query := `SELECT error_class, COUNT(*), MAX(dead_at) FROM dead_letters
+	          WHERE account_id = $1 AND ($2 = '' OR campaign_id = $2) AND status = 'dead'
+	          GROUP BY error_class ORDER BY COUNT(*) DESC`
+	rows, err := r.db.Query(ctx, query, accountID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var summary []domain.DeadLetterSummary
+	for rows.Next() {
+		var s domain.DeadLetterSummary
+		if err := rows.Scan(&s.ErrorClass, &s.Count, &s.LastDeadAt); err != nil {
+			return nil, err
+		}
+		summary = append(summary, s)
+	}
+	return summary, rows.Err()
+}
+
+func (r *deadLetterRepository) ReplayDeadLetters(ctx context.Context, batch *models.QueueBatch, items []*models.QueueItem,
+	letterIDs []primitive.ObjectID, now time.Time) error {
+	if len(items) != len(letterIDs) {
+		return errors.New("each replayed dead letter needs exactly one queue item")
+	}
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	ids := make([]string, len(items))
+	itemIDs := make([]string, len(items))
+	for i := range items {
+		ids[i] = letterIDs[i].Hex()
+		itemIDs[i] = items[i].ID.Hex()
+	}
+	query := `UPDATE dead_letters d
+	          SET status = 'replayed', replayed_at = $3, replay_batch_id = $4, replay_item_id = r.item_id
+	          FROM unnest($1::text[], $2::text[]) AS r(id, item_id)
+	          WHERE d.id = r.id AND d.account_id = $5 AND d.status = 'dead'`
+	tag, err := tx.Exec(ctx, query, ids, itemIDs, now, batch.ID.Hex(), batch.AccountID)
+	if err != nil {
+		return err
+	}
+	if int(tag.RowsAffected()) != len(ids) {
+		return errors.New("some dead letters were already replayed or purged")
+	}
+
+	if err := insertQueueBatch(ctx, tx, batch, items); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *deadLetterRepository) PurgeDeadLetters(ctx context.Context, accountID string, filter domain.DeadLetterFilter, before time.Time) (int, error) {
+	where, args := deadLetterWhere(accountID, filter)
+	query := `DELETE FROM dead_letters WHERE ` + where + ` AND ($6::timestamptz IS NULL OR dead_at < $6)`
+	tag, err := r.db.Exec(ctx, query, append(args, zeroTimeToNull(before))...)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// ---------------------------------------------------------
+// توابع کمکی
+// ---------------------------------------------------------
+
+// deadLetterWhere شرط فیلتر با پارامترهای $1 تا $5
+func deadLetterWhere(accountID string, filter domain.DeadLetterFilter) (string, []any) {
+	where := `account_id = $1 AND ($2 = '' OR campaign_id = $2) AND ($3 = '' OR error_class = $3)
+	          AND ($4 = '' OR status = $4) AND ($5 = '' OR recipient_email = $5)`
+	return where, []any{accountID, filter.CampaignID, filter.ErrorClass, filter.Status, filter.Email}
+}
+
+func scanDeadLetter(row pgx.Row) (*models.DeadLetter, error) {
+	var d models.DeadLetter
+	var id, batchID, itemID, status string
+	var campaignID, replayBatchID, replayItemID *string
+	var payload []byte
+	var replayedAt *time.Time
+	if err := row.Scan(&id, &d.AccountID, &campaignID, &batchID, &itemID, &d.RecipientEmail, &d.ErrorClass, &d.LastError,
+		&d.Attempts, &payload, &status, &d.DeadAt, &replayedAt, &replayBatchID, &replayItemID); err != nil {
+		return nil, err
+	}
+	d.ID, _ = primitive.ObjectIDFromHex(id)
+	d.CampaignID = nullToOID(campaignID)
+	d.BatchID, _ = primitive.ObjectIDFromHex(batchID)
+	d.ItemID, _ = primitive.ObjectIDFromHex(itemID)
+	d.Status = models.DeadLetterStatus(status)
+	d.ReplayBatchID = nullToOID(replayBatchID)
+	d.ReplayItemID = nullToOID(replayItemID)
+	if replayedAt != nil {
+		d.ReplayedAt = *replayedAt
+	}
+	if len(payload) > 0 {
+		if err := json.Unmarshal(payload, &d.Payload); err != nil {
+			return nil, err
+		}
+	}
+	return &d, nil
+}
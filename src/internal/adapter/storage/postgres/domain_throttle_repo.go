@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type domainThrottleRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewDomainThrottleRepository(db *pgxpool.Pool) port.IDomainThrottleRepository {
+	return &domainThrottleRepository{db: db}
+}
+
+func (r *domainThrottleRepository) ListRules(ctx context.Context, accountID string) ([]*models.DomainThrottleRule, error) {
+	query := `SELECT id, account_id, sending_domain, recipient_domain, per_minute, burst, created_at, updated_at
+	          FROM domain_throttle_rules WHERE account_id = $1 OR account_id = ''
+	          ORDER BY recipient_domain, account_id DESC, sending_domain DESC`
+	rows, err := r.db.Query(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*models.DomainThrottleRule
+	for rows.Next() {
+		var rule models.DomainThrottleRule
+		var id string
+		if err := rows.Scan(&id, &rule.AccountID, &rule.SendingDomain, &rule.RecipientDomain, &rule.PerMinute, &rule.Burst,
+			&rule.CreatedAt, &rule.UpdatedAt); err != nil {
+			return nil, err
+		}
+		rule.ID, _ = primitive.ObjectIDFromHex(id)
+		rules = append(rules, &rule)
+	}
+	return rules, rows.Err()
+}
+
+// SaveRule: قانون موجود با همان (اکانت، دامنه فرستنده، دامنه گیرنده) به‌روز می‌شود و شناسه آن برمی‌گردد
+func (r *domainThrottleRepository) SaveRule(ctx context.Context, rule *models.DomainThrottleRule) error {
+	query := `INSERT INTO domain_throttle_rules (id, account_id, sending_domain, recipient_domain, per_minute, burst, created_at, updated_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	          ON CONFLICT (account_id, sending_domain, recipient_domain)
+	          DO UPDATE SET per_minute = EXCLUDED.per_minute, burst = EXCLUDED.burst, updated_at = EXCLUDED.updated_at
+	          RETURNING id, created_at`
+	var id string
+	err := r.db.QueryRow(ctx, query, rule.ID.Hex(), rule.AccountID, rule.SendingDomain, rule.RecipientDomain, rule.PerMinute,
+		rule.Burst, rule.CreatedAt, rule.UpdatedAt).Scan(&id, &rule.CreatedAt)
+	if err != nil {
+		return err
+	}
+	rule.ID, _ = primitive.ObjectIDFromHex(id)
+	return nil
+}
+
+func (r *domainThrottleRepository) DeleteRule(ctx context.Context, accountID string, id primitive.ObjectID) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM domain_throttle_rules WHERE id = $1 AND account_id = $2`, id.Hex(), accountID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.New("throttle rule not found")
+	}
+	return nil
+}
+
+// TakeToken: درج ردیف (یا به‌روزرسانی بی‌اثر آن) ردیف را تا پایان تراکنش قفل می‌کند تا برداشت Worker ها پشت سر هم انجام شود
+func (r *domainThrottleRepository) TakeToken(ctx context.Context, key string, now time.Time, rate, burst float64) (time.Duration, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	b := models.TokenBucket{Key: key}
+	err = tx.QueryRow(ctx, `INSERT INTO domain_throttle_buckets (bucket_key, tokens, updated_at) VALUES ($1, $2, $3)
+	                        ON CONFLICT (bucket_key) DO UPDATE SET bucket_key = EXCLUDED.bucket_key
+	                        RETURNING tokens, updated_at`, key, burst, now).Scan(&b.Tokens, &b.UpdatedAt)
+	if err != nil {
+		return 0, err
+	}
+	wait := b.Take(now, rate, burst)
+	if _, err := tx.Exec(ctx, `UPDATE domain_throttle_buckets SET tokens = $2, updated_at = $3 WHERE bucket_key = $1`,
+		key, b.Tokens, b.UpdatedAt); err != nil {
+		return 0, err
+	}
+	return wait, tx.Commit(ctx)
+}
+
+// RecordDomainEvents: شمارنده‌های هر (اکانت، دامنه، بازه) با مقادیر جدید جمع می‌شوند؛ شمارش دارای EventID فقط اگر
+// شناسه در همین تراکنش برای اولین بار ثبت شود
+func (r *domainThrottleRepository) RecordDomainEvents(ctx context.Context, counts []models.DomainEventCount) error {
+	if len(counts) == 0 {
+		return nil
+	}
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	batch := &pgx.Batch{}
+	for _, c := range counts {
+		batch.Queue(`WITH fresh AS (
+		                 INSERT INTO processed_email_events (event_id, scope)
+		                 SELECT $8::text, $9::text WHERE $8::text <> ''
+		                 ON CONFLICT DO NOTHING RETURNING event_id)
+		             INSERT INTO domain_event_stats (account_id, recipient_domain, window_start, sent, delivered, bounced, deferred)
+		             SELECT $1::text, $2::text, $3::timestamptz, $4::bigint, $5::bigint, $6::bigint, $7::bigint
+		             WHERE $8::text = '' OR EXISTS (SELECT 1 FROM fresh)
+		             ON CONFLICT (account_id, recipient_domain, window_start) DO UPDATE SET
+		                 sent = domain_event_stats.sent + EXCLUDED.sent,
+		                 delivered = domain_event_stats.delivered + EXCLUDED.delivered,
+		                 bounced = domain_event_stats.bounced + EXCLUDED.bounced,
+		                 deferred = domain_event_stats.deferred + EXCLUDED.deferred`,
+			c.AccountID, c.RecipientDomain, c.WindowStart, c.Sent, c.Delivered, c.Bounced, c.Deferred, c.EventID, eventScopeDomainStats)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// RecentDomainStats: مخرج نسبت‌ها تعداد ارسال است؛ اگر رویداد sent کمتر از نتیجه‌ها رسیده باشد مجموع نتیجه‌ها
+func (r *domainThrottleRepository) RecentDomainStats(ctx context.Context, accountID string, since time.Time) ([]models.DomainStat, error) {
+	query := `SELECT recipient_domain, SUM(sent), SUM(delivered), SUM(bounced), SUM(deferred)
+	          FROM domain_event_stats
+	          WHERE account_id = $1 AND window_start >= $2
+	          GROUP BY recipient_domain`
+	rows, err := r.db.Query(ctx, query, accountID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []models.DomainStat
+	for rows.Next() {
+		var st models.DomainStat
+		var sent, delivered, bounced, deferred int
+		if err := rows.Scan(&st.Domain, &sent, &delivered, &bounced, &deferred); err != nil {
+			return nil, err
+		}
+		st.Count = max(sent, delivered+bounced+deferred)
+		if st.Count > 0 {
+			st.DeliveryRate = float64(delivered) / float64(st.Count)
+			st.BounceRate = float64(bounced) / float64(st.Count)
+			st.DeferralRate = float64(deferred) / float64(st.Count)
+		}
+		stats = append(stats, st)
+	}
+	return stats, rows.Err()
+}
+
+func (r *domainThrottleRepository) PruneThrottleState(ctx context.Context, bucketsBefore, statsBefore time.Time) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM domain_throttle_buckets WHERE updated_at < $1`, bucketsBefore); err != nil {
+		return err
+	}
+	_, err := r.db.Exec(ctx, `DELETE FROM domain_event_stats WHERE window_start < $1`, statsBefore)
+	return err
+}
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestRecordDomainEvents(t *testing.T) {
+	db := testPool(t)
+	repo := NewDomainThrottleRepository(db)
+	ctx := context.Background()
+
+	accountID := primitive.NewObjectID().Hex()
+	delivered, bounced := primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex()
+	cleanup(t, db, `DELETE FROM domain_event_stats WHERE account_id = $1`, accountID)
+	cleanup(t, db, `DELETE FROM processed_email_events WHERE event_id = ANY($1)`, []string{delivered, bounced})
+
+	window := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	count := func(eventID string, sent, ok, bounce int) models.DomainEventCount {
+		return models.DomainEventCount{EventID: eventID, AccountID: accountID, RecipientDomain: "gmail.com",
+			WindowStart: window, Sent: sent, Delivered: ok, Bounced: bounce}
+	}
+	if err := repo.RecordDomainEvents(ctx, []models.DomainEventCount{
+		count("", 4, 0, 0),
+		count(delivered, 0, 1, 0),
+		count(bounced, 0, 0, 1),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// پیام تکراری صف: شناسه‌های ثبت شده دوباره شمرده نمی‌شوند
+	if err := repo.RecordDomainEvents(ctx, []models.DomainEventCount{count(delivered, 0, 1, 0), count(bounced, 0, 0, 1)}); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := repo.RecentDomainStats(ctx, accountID, window)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != 1 {
+		t.Fatalf("RecentDomainStats() returned %d domains, want 1", len(stats))
+	}
+	st := stats[0]
+	if st.Domain != "gmail.com" || st.Count != 4 || st.DeliveryRate != 0.25 || st.BounceRate != 0.25 {
+		t.Errorf("RecentDomainStats() = %+v, want gmail.com with count 4 and 0.25 delivery and bounce rates", st)
+	}
+}
+
+func TestTakeToken(t *testing.T) {
+	db := testPool(t)
+	repo := NewDomainThrottleRepository(db)
+	ctx := context.Background()
+
+	key := "test:" + primitive.NewObjectID().Hex()
+	cleanup(t, db, `DELETE FROM domain_throttle_buckets WHERE bucket_key = $1`, key)
+
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	// سطل پر با ظرفیت ۲ و نرخ ۱ در ثانیه: دو برداشت فوری و سومی یک ثانیه انتظار
+	for i, want := range []time.Duration{0, 0, time.Second} {
+		wait, err := repo.TakeToken(ctx, key, now, 1, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if wait != want {
+			t.Errorf("take %d: wait = %v, want %v", i+1, wait, want)
+		}
+	}
+}
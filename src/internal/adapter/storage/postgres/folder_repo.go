@@ -0,0 +1,262 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// شناسه‌های ObjectID به صورت hex (VARCHAR(24)) ذخیره می‌شوند و NULL یعنی ریشه
+type folderRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewFolderRepository(db *pgxpool.Pool) port.IFolderRepository {
+	return &folderRepository{db: db}
+}
+
+func (r *folderRepository) CreateFolder(ctx context.Context, f *models.MessageFolder) error {
+	query := `INSERT INTO message_folders (id, account_id, name, description, parent_id, created_at, updated_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	_, err := r.db.Exec(ctx, query, f.ID.Hex(), f.AccountID, f.Name, f.Description, oidToNull(f.ParentID), f.CreatedAt, f.UpdatedAt)
+	return err
+}
+
+func (r *folderRepository) UpdateFolder(ctx context.Context, f *models.MessageFolder) error {
+	query := `UPDATE message_folders SET name = $1, description = $2, parent_id = $3, updated_at = $4
+	          WHERE id = $5 AND account_id = $6`
+	tag, err := r.db.Exec(ctx, query, f.Name, f.Description, oidToNull(f.ParentID), f.UpdatedAt, f.ID.Hex(), f.AccountID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.New("folder not found or access denied")
+	}
+	return nil
+}
+
+func (r *folderRepository) GetFolder(ctx context.Context, accountID string, id primitive.ObjectID) (*models.MessageFolder, error) {
+	query := `SELECT id, account_id, name, description, parent_id, created_at, updated_at
+	          FROM message_folders WHERE id = $1 AND account_id = $2`
+	f, err := scanFolder(r.db.QueryRow(ctx, query, id.Hex(), accountID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.New("folder not found")
+	}
+	return f, err
+}
+
+func (r *folderRepository) ListFolders(ctx context.Context, accountID string) ([]models.MessageFolder, error) {
+	query := `SELECT id, account_id, name, description, parent_id, created_at, updated_at
+	          FROM message_folders WHERE account_id = $1 ORDER BY name`
+	rows, err := r.db.Query(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var folders []models.MessageFolder
+	for rows.Next() {
+		f, err := scanFolder(rows)
+		if err != nil {
+			return nil, err
+		}
+		folders = append(folders, *f)
+	}
+	return folders, rows.Err()
+}
+
+func (r *folderRepository) ListSubtreeIDs(ctx context.Context, accountID string, id primitive.ObjectID) ([]primitive.ObjectID, error) {
+	query := `WITH RECURSIVE subtree AS (
+	              SELECT id FROM message_folders WHERE id = $1 AND account_id = $2
+	              UNION ALL
+	              SELECT f.id FROM message_folders f JOIN subtree s ON f.parent_id = s.id
+	              WHERE f.account_id = $2
+	          )
+	          SELECT id FROM subtree`
+	rows, err := r.db.Query(ctx, query, id.Hex(), accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []primitive.ObjectID
+	for rows.Next() {
+		var hex string
+		if err := rows.Scan(&hex); err != nil {
+			return nil, err
+		}
+		oid, err := primitive.ObjectIDFromHex(hex)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, oid)
+	}
+	return ids, rows.Err()
+}
+
+func (r *folderRepository) CountContents(ctx context.Context, accountID string, id primitive.ObjectID) (int, int, int, error) {
+	query := `SELECT
+	              (SELECT COUNT(*) FROM message_folders WHERE account_id = $1 AND parent_id = $2),
+	              (SELECT COUNT(*) FROM templates WHERE account_id = $1 AND folder_id = $2),
+	              (SELECT COUNT(*) FROM messages WHERE account_id = $1 AND folder_id = $2)`
+	var folders, templates, messages int
+	err := r.db.QueryRow(ctx, query, accountID, id.Hex()).Scan(&folders, &templates, &messages)
+	return folders, templates, messages, err
+}
+
+func (r *folderRepository) DeleteFolders(ctx context.Context, accountID string, ids []primitive.ObjectID) error {
+	hexIDs := oidsToHex(ids)
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// ترتیب مهم است: ابتدا محتوا و بعد خود پوشه‌ها
+	statements := []string{
+		`DELETE FROM templates WHERE account_id = $1 AND folder_id = ANY($2)`,
+		`DELETE FROM messages WHERE account_id = $1 AND folder_id = ANY($2)`,
+		`DELETE FROM message_folders WHERE account_id = $1 AND id = ANY($2)`,
+	}
+	for _, q := range statements {
+		if _, err := tx.Exec(ctx, q, accountID, hexIDs); err != nil {
+			return fmt.Errorf("failed to delete folder contents: %w", err)
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *folderRepository) MoveTemplate(ctx context.Context, accountID, templateID string, folderID primitive.ObjectID) error {
+	tag, err := r.db.Exec(ctx, `UPDATE templates SET folder_id = $1, updated_at = NOW() WHERE id = $2 AND account_id = $3`,
+		oidToNull(folderID), templateID, accountID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.New("template not found or access denied")
+	}
+	return nil
+}
+
+func (r *folderRepository) MoveMessage(ctx context.Context, accountID string, messageID, folderID primitive.ObjectID) error {
+	tag, err := r.db.Exec(ctx, `UPDATE messages SET folder_id = $1, updated_at = NOW() WHERE id = $2 AND account_id = $3`,
+		oidToNull(folderID), messageID.Hex(), accountID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.New("message not found or access denied")
+	}
+	return nil
+}
+
+func (r *folderRepository) ListTemplatesInFolders(ctx context.Context, accountID string, ids []primitive.ObjectID) ([]*domain.Template, error) {
+	query := `SELECT id, account_id, name, COALESCE(current_version_id::text, ''), default_language,
+	                 COALESCE(folder_id, ''), created_at, updated_at
+	          FROM templates
+	          WHERE account_id = $1 AND (folder_id = ANY($2) OR ($3 AND folder_id IS NULL))
+	          ORDER BY name`
+	rows, err := r.db.Query(ctx, query, accountID, oidsToHex(ids), containsRoot(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []*domain.Template
+	for rows.Next() {
+		var t domain.Template
+		if err := rows.Scan(&t.ID, &t.AccountID, &t.Name, &t.CurrentVersionID, &t.DefaultLanguage,
+			&t.FolderID, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return nil, err
+		}
+		templates = append(templates, &t)
+	}
+	return templates, rows.Err()
+}
+
+func (r *folderRepository) ListMessagesInFolders(ctx context.Context, accountID string, ids []primitive.ObjectID) ([]*models.Message, error) {
+	query := `SELECT id, account_id, name, subject, COALESCE(template_id, ''), is_template, language,
+	                 folder_id, created_at, updated_at
+	          FROM messages
+	          WHERE account_id = $1 AND (folder_id = ANY($2) OR ($3 AND folder_id IS NULL))
+	          ORDER BY name`
+	rows, err := r.db.Query(ctx, query, accountID, oidsToHex(ids), containsRoot(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []*models.Message
+	for rows.Next() {
+		var m models.Message
+		var id string
+		var folderID *string
+		if err := rows.Scan(&id, &m.AccountID, &m.Name, &m.Subject, &m.TemplateID, &m.IsTemplate, &m.Language,
+			&folderID, &m.CreatedAt, &m.UpdatedAt); err != nil {
+			return nil, err
+		}
+		m.ID, _ = primitive.ObjectIDFromHex(id)
+		m.FolderID = nullToOID(folderID)
+		messages = append(messages, &m)
+	}
+	return messages, rows.Err()
+}
+
+// ---------------------------------------------------------
+// توابع کمکی تبدیل ObjectID
+// ---------------------------------------------------------
+
+func scanFolder(row pgx.Row) (*models.MessageFolder, error) {
+	var f models.MessageFolder
+	var id string
+	var parentID *string
+	if err := row.Scan(&id, &f.AccountID, &f.Name, &f.Description, &parentID, &f.CreatedAt, &f.UpdatedAt); err != nil {
+		return nil, err
+	}
+	f.ID, _ = primitive.ObjectIDFromHex(id)
+	f.ParentID = nullToOID(parentID)
+	return &f, nil
+}
+
+func oidToNull(id primitive.ObjectID) *string {
+	if id.IsZero() {
+		return nil
+	}
+	hex := id.Hex()
+	return &hex
+}
+
+func nullToOID(hex *string) primitive.ObjectID {
+	if hex == nil {
+		return primitive.NilObjectID
+	}
+	id, _ := primitive.ObjectIDFromHex(*hex)
+	return id
+}
+
+func oidsToHex(ids []primitive.ObjectID) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !id.IsZero() {
+			out = append(out, id.Hex())
+		}
+	}
+	return out
+}
+
+func containsRoot(ids []primitive.ObjectID) bool {
+	for _, id := range ids {
+		if id.IsZero() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,363 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const journeyColumns = `id, automation_id, account_id, subscriber_id, subscriber_email, current_step_id, current_step_number,
+	status, entered_at, last_updated_at, completed_at, next_step_at, step_history, variables`
+
+type journeyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewJourneyRepository(db *pgxpool.Pool) port.IJourneyRepository {
+	return &journeyRepository{db: db}
+}
+
+func (r *journeyRepository) CreateJourney(ctx context.Context, j *models.AutomationJourney) error {
+	history, vars, err := marshalJourney(j)
+	if err != nil {
+		return err
+	}
+	query := `INSERT INTO automation_journeys (` + journeyColumns + `)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`
+	_, err = r.db.Exec(ctx, query,
+		j.ID.Hex(), j.AutomationID.Hex(), j.AccountID, oidToNull(j.SubscriberID), j.SubscriberEmail, oidToNull(j.CurrentStepID),
+		j.CurrentStepNumber, j.Status, j.EnteredAt, j.LastUpdatedAt, zeroTimeToNull(j.CompletedAt), j.NextStepAt, history, vars)
+	return err
+}
+
+func (r *journeyRepository) GetJourney(ctx context.Context, accountID string, id primitive.ObjectID) (*models.AutomationJourney, error) {
+	query := `SELECT ` + journeyColumns + ` FROM automation_journeys WHERE id = $1 AND account_id = $2`
+	j, err := scanJourney(r.db.QueryRow(ctx, query, id.Hex(), accountID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.New("journey not found")
+	}
+	return j, err
+}
+
+func (r *journeyRepository) HasJourney(ctx context.Context, automationID primitive.ObjectID, email string, since time.Time) (bool, error) {
+	var exists bool
+	query := `SELECT EXISTS (SELECT 1 FROM automation_journeys WHERE automation_id = $1 AND subscriber_email = $2 AND entered_at >= $3)`
+	err := r.db.QueryRow(ctx, query, automationID.Hex(), email, since).Scan(&exists)
+	return exists, err
+}
+
+func (r *journeyRepository) HasActiveJourney(ctx context.Context, automationID primitive.ObjectID, email string) (bool, error) {
+	var exists bool
+	query := `SELECT EXISTS (SELECT 1 FROM automation_journeys WHERE automation_id = $1 AND subscriber_email = $2 AND status IN ('active', 'paused'))`
+	err := r.db.QueryRow(ctx, query, automationID.Hex(), email).Scan(&exists)
+	return exists, err
+}
+
+// ClaimDueJourneys: جریان‌هایی که Lease ندارند یا Lease آن‌ها منقضی شده برداشته می‌شوند
+func (r *journeyRepository) ClaimDueJourneys(ctx context.Context, workerID string, now time.Time, limit int, lease time.Duration) ([]*models.AutomationJourney, error) {
+	query := `UPDATE automation_journeys SET locked_by = $1, locked_until = $2
+	          WHERE id IN (
+	              SELECT id FROM automation_journeys
+	              WHERE status = 'active' AND next_step_at <= $3 AND (locked_until IS NULL OR locked_until < $3)
+	              ORDER BY next_step_at
+	              LIMIT $4
+	              FOR UPDATE SKIP LOCKED
+	          )
+	          RETURNING ` + journeyColumns
+	rows, err := r.db.Query(ctx, query, workerID, now.Add(lease), now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var journeys []*models.AutomationJourney
+	for rows.Next() {
+		j, err := scanJourney(rows)
+		if err != nil {
+			return nil, err
+		}
+		journeys = append(journeys, j)
+	}
+	return journeys, rows.Err()
+}
+
+func (r *journeyRepository) SaveJourney(ctx context.Context, j *models.AutomationJourney, workerID string) error {
+	history, vars, err := marshalJourney(j)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE automation_journeys
+	          SET current_step_id = $1, current_step_number = $2, status = $3, last_updated_at = $4, completed_at = $5,
+	              next_step_at = $6, step_history = $7, variables = $8, locked_by = NULL, locked_until = NULL
+	          WHERE id = $9 AND locked_by = $10`
+	tag, err := r.db.Exec(ctx, query,
+		oidToNull(j.CurrentStepID), j.CurrentStepNumber, j.Status, j.LastUpdatedAt, zeroTimeToNull(j.CompletedAt),
+		j.NextStepAt, history, vars, j.ID.Hex(), workerID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.New("journey lease lost")
+	}
+	return nil
+}
+
+func (r *journeyRepository) RecordEngagement(ctx context.Context, journeyID, stepID primitive.ObjectID, eventType string, at time.Time) (bool, error) {
+	query := `INSERT INTO automation_journey_events (journey_id, step_id, event_type, occurred_at)
+	          VALUES ($1, $2, $3, $4)
+	          ON CONFLICT (journey_id, step_id, event_type) DO NOTHING`
+	tag, err := r.db.Exec(ctx, query, journeyID.Hex(), stepID.Hex(), eventType, at)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *journeyRepository) ListEngagement(ctx context.Context, journeyID primitive.ObjectID) ([]models.JourneyStep, error) {
+	rows, err := r.db.Query(ctx, `SELECT step_id, event_type, occurred_at FROM automation_journey_events WHERE journey_id = $1 ORDER BY occurred_at`,
+		journeyID.Hex())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []models.JourneyStep
+	for rows.Next() {
+		var stepID, eventType string
+		var at time.Time
+		if err := rows.Scan(&stepID, &eventType, &at); err != nil {
+			return nil, err
+		}
+		ev := models.JourneyStep{StepType: models.STEP_EMAIL, EmailStatus: eventType}
+		ev.StepID, _ = primitive.ObjectIDFromHex(stepID)
+		if eventType == "clicked" {
+			ev.EmailClickedAt = at
+		} else {
+			ev.EmailOpenedAt = at
+		}
+		events = append(events, ev)
+	}
+	return events, rows.Err()
+}
+
+func (r *journeyRepository) ListJourneys(ctx context.Context, accountID string, filter domain.JourneyFilter, limit, offset int) ([]*models.AutomationJourney, int, error) {
+	where := `account_id = $1 AND ($2 = '' OR automation_id = $2) AND ($3 = '' OR subscriber_email = $3) AND ($4 = '' OR status = $4)`
+	args := []any{accountID, filter.AutomationID, filter.Email, filter.Status}
+
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM automation_journeys WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT ` + journeyColumns + ` FROM automation_journeys WHERE ` + where + `
+	          ORDER BY entered_at DESC LIMIT $5 OFFSET $6`
+	rows, err := r.db.Query(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var journeys []*models.AutomationJourney
+	for rows.Next() {
+		j, err := scanJourney(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		journeys = append(journeys, j)
+	}
+	return journeys, total, rows.Err()
+}
+
+func (r *journeyRepository) LockJourney(ctx context.Context, accountID string, id primitive.ObjectID, owner string,
+	now time.Time, lease time.Duration) (*models.AutomationJourney, error) {
+	query := `UPDATE automation_journeys SET locked_by = $3, locked_until = $4
+	          WHERE id = $1 AND account_id = $2 AND (locked_until IS NULL OR locked_until < $5)
+	          RETURNING ` + journeyColumns
+	j, err := scanJourney(r.db.QueryRow(ctx, query, id.Hex(), accountID, owner, now.Add(lease), now))
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return j, err
+	}
+
+	// تشخیص «وجود ندارد» از «در حال پردازش»
+	if _, err := r.GetJourney(ctx, accountID, id); err != nil {
+		return nil, err
+	}
+	return nil, errors.New("journey is being processed, try again")
+}
+
+func (r *journeyRepository) SetJourneysStatus(ctx context.Context, accountID string, automationID primitive.ObjectID, from, to string,
+	entry models.JourneyStep, now time.Time) (int, int, error) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return 0, 0, err
+	}
+	// ورودی اقدام دستی با مرحله فعلی هر جریان تکمیل می‌شود
+	query := `UPDATE automation_journeys
+	          SET status = $4, last_updated_at = $5,
+	              next_step_at = CASE WHEN $4 = 'active' THEN $5 ELSE next_step_at END,
+	              step_history = step_history || jsonb_build_array($6::jsonb || jsonb_build_object(
+	                  'step_id', COALESCE(current_step_id, '000000000000000000000000'), 'step_number', current_step_number))
+	          WHERE account_id = $1 AND automation_id = $2 AND status = $3 AND (locked_until IS NULL OR locked_until < $5)`
+	tag, err := r.db.Exec(ctx, query, accountID, automationID.Hex(), from, to, now, data)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var busy int
+	err = r.db.QueryRow(ctx, `SELECT COUNT(*) FROM automation_journeys
+	                          WHERE account_id = $1 AND automation_id = $2 AND status = $3 AND locked_until >= $4`,
+		accountID, automationID.Hex(), from, now).Scan(&busy)
+	return int(tag.RowsAffected()), busy, err
+}
+
+func (r *journeyRepository) ListJourneysByEmail(ctx context.Context, accountID, email string, since time.Time) ([]*models.AutomationJourney, error) {
+	query := `SELECT ` + journeyColumns + ` FROM automation_journeys
+	          WHERE account_id = $1 AND subscriber_email = $2 AND last_updated_at >= $3`
+	rows, err := r.db.Query(ctx, query, accountID, email, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var journeys []*models.AutomationJourney
+	for rows.Next() {
+		j, err := scanJourney(rows)
+		if err != nil {
+			return nil, err
+		}
+		journeys = append(journeys, j)
+	}
+	return journeys, rows.Err()
+}
+
+func (r *journeyRepository) CountActiveJourneys(ctx context.Context, automationID primitive.ObjectID) (int, error) {
+	var n int
+	err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM automation_journeys WHERE automation_id = $1 AND status = 'active'`,
+		automationID.Hex()).Scan(&n)
+	return n, err
+}
+
+// SplitBranchStats: شاخه هر جریان از آخرین ورودی مرحله تقسیم در step_history خوانده می‌شود و ایمیل‌های
+// ارسال شده بعد از آن به همان شاخه نسبت داده می‌شوند؛ باز شدن/کلیک از automation_journey_events می‌آید.
+// کلیک روی حداقل یکی از ایمیل‌های شاخه تبدیل (Conversion) حساب می‌شود.
+func (r *journeyRepository) SplitBranchStats(ctx context.Context, automationID, stepID primitive.ObjectID) (map[int]models.StepStats, error) {
+	query := `WITH branch AS (
+	              SELECT DISTINCT ON (j.id) j.id, j.status, COALESCE((h.entry->>'split_group')::int, 0) AS grp, h.pos
+	              FROM automation_journeys j, jsonb_array_elements(j.step_history) WITH ORDINALITY AS h(entry, pos)
+	              WHERE j.automation_id = $1 AND h.entry->>'step_id' = $2
+	              ORDER BY j.id, h.pos DESC
+	          ), sent AS (
+	              SELECT b.id, e.entry->>'step_id' AS step_id
+	              FROM branch b
+	              JOIN automation_journeys j ON j.id = b.id
+	              CROSS JOIN LATERAL jsonb_array_elements(j.step_history) WITH ORDINALITY AS e(entry, pos)
+	              WHERE e.pos > b.pos AND e.entry->>'step_type' = 'email' AND e.entry->>'status' = 'completed'
+	          ), engagement AS (
+	              SELECT s.id,
+	                     COUNT(*) AS sent,
+	                     COUNT(*) FILTER (WHERE EXISTS (
+	                         SELECT 1 FROM automation_journey_events ev WHERE ev.journey_id = s.id AND ev.step_id = s.step_id
+	                     )) AS opened,
+	                     COUNT(*) FILTER (WHERE EXISTS (
+	                         SELECT 1 FROM automation_journey_events ev
+	                         WHERE ev.journey_id = s.id AND ev.step_id = s.step_id AND ev.event_type = 'clicked'
+	                     )) AS clicked
+	              FROM sent s
+	              GROUP BY s.id
+	          )
+	          SELECT b.grp,
+	                 COUNT(*),
+	                 COUNT(*) FILTER (WHERE b.status = 'completed'),
+	                 COUNT(*) FILTER (WHERE b.status = 'exited'),
+	                 COALESCE(SUM(g.sent), 0),
+	                 COALESCE(SUM(g.opened), 0),
+	                 COALESCE(SUM(g.clicked), 0),
+	                 COUNT(*) FILTER (WHERE g.clicked > 0)
+	          FROM branch b
+	          LEFT JOIN engagement g ON g.id = b.id
+	          GROUP BY b.grp`
+	rows, err := r.db.Query(ctx, query, automationID.Hex(), stepID.Hex())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	now := time.Now()
+	stats := make(map[int]models.StepStats)
+	for rows.Next() {
+		var group, converted int
+		var st models.StepStats
+		if err := rows.Scan(&group, &st.EntriesCount, &st.CompletionsCount, &st.DropOffs,
+			&st.EmailsSent, &st.EmailsOpened, &st.EmailsClicked, &converted); err != nil {
+			return nil, err
+		}
+		if st.EntriesCount > 0 {
+			st.ConversionRate = float64(converted) / float64(st.EntriesCount)
+		}
+		st.LastUpdatedAt = now
+		stats[group] = st
+	}
+	return stats, rows.Err()
+}
+
+// ---------------------------------------------------------
+// توابع کمکی
+// ---------------------------------------------------------
+
+func marshalJourney(j *models.AutomationJourney) ([]byte, []byte, error) {
+	history, err := json.Marshal(j.StepHistory)
+	if err != nil {
+		return nil, nil, err
+	}
+	vars, err := json.Marshal(j.Variables)
+	if err != nil {
+		return nil, nil, err
+	}
+	return history, vars, nil
+}
+
+func scanJourney(row pgx.Row) (*models.AutomationJourney, error) {
+	var j models.AutomationJourney
+	var id, automationID string
+	var subscriberID, currentStepID *string
+	var completedAt *time.Time
+	var history, vars []byte
+	if err := row.Scan(&id, &automationID, &j.AccountID, &subscriberID, &j.SubscriberEmail, &currentStepID, &j.CurrentStepNumber,
+		&j.Status, &j.EnteredAt, &j.LastUpdatedAt, &completedAt, &j.NextStepAt, &history, &vars); err != nil {
+		return nil, err
+	}
+	j.ID, _ = primitive.ObjectIDFromHex(id)
+	j.AutomationID, _ = primitive.ObjectIDFromHex(automationID)
+	j.SubscriberID = nullToOID(subscriberID)
+	j.CurrentStepID = nullToOID(currentStepID)
+	if completedAt != nil {
+		j.CompletedAt = *completedAt
+	}
+	if len(history) > 0 {
+		if err := json.Unmarshal(history, &j.StepHistory); err != nil {
+			return nil, err
+		}
+	}
+	if len(vars) > 0 {
+		if err := json.Unmarshal(vars, &j.Variables); err != nil {
+			return nil, err
+		}
+	}
+	return &j, nil
+}
+
+// zeroTimeToNull زمان صفر (مقدار پیش‌فرض مدل‌های bson) را NULL ذخیره می‌کند
+func zeroTimeToNull(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
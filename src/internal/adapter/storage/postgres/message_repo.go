@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type messageRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewMessageRepository(db *pgxpool.Pool) port.IMessageRepository {
+	return &messageRepository{db: db}
+}
+
+func (r *messageRepository) GetMessage(ctx context.Context, accountID string, id primitive.ObjectID) (*models.Message, error) {
+	query := `SELECT id, account_id, name, COALESCE(subject, ''), COALESCE(pre_header, ''), COALESCE(body_html, ''), COALESCE(body_text, ''),
+	                 COALESCE(template_id, ''), is_template, language, folder_id, variables, metadata, created_at, updated_at
+	          FROM messages WHERE id = $1 AND account_id = $2`
+
+	var m models.Message
+	var msgID string
+	var folderID *string
+	var vars, meta []byte
+	err := r.db.QueryRow(ctx, query, id.Hex(), accountID).Scan(&msgID, &m.AccountID, &m.Name, &m.Subject, &m.PreHeader,
+		&m.BodyHTML, &m.BodyText, &m.TemplateID, &m.IsTemplate, &m.Language, &folderID, &vars, &meta, &m.CreatedAt, &m.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.New("message not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	m.ID, _ = primitive.ObjectIDFromHex(msgID)
+	m.FolderID = nullToOID(folderID)
+	if len(vars) > 0 {
+		if err := json.Unmarshal(vars, &m.Variables); err != nil {
+			return nil, err
+		}
+	}
+	if len(meta) > 0 {
+		if err := json.Unmarshal(meta, &m.Metadata); err != nil {
+			return nil, err
+		}
+	}
+	return &m, nil
+}
@@ -0,0 +1,346 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const queueItemColumns = `id, batch_id, account_id, campaign_id, message_id, recipient_email, recipient_data, status, priority,
+	created_at, scheduled_at, processed_at, completed_at, retry_count, max_retries, last_error, external_id,
+	queue_name, exchange, routing_key, server, error_history, sending_domain, campaign_ref`
+
+var errQueueLeaseLost = errors.New("queue item lease lost")
+
+type queueRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewQueueRepository(db *pgxpool.Pool) port.IQueueRepository {
+	return &queueRepository{db: db}
+}
+
+func (r *queueRepository) CreateBatch(ctx context.Context, b *models.QueueBatch, items []*models.QueueItem) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertQueueBatch(ctx, tx, b, items); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *queueRepository) AppendItems(ctx context.Context, batchID primitive.ObjectID, items []*models.QueueItem) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// دسته تمام شده دوباره باز می‌شود
+	tag, err := tx.Exec(ctx, `UPDATE send_queue_batches
+	                          SET item_count = item_count + $2,
+	                              status = CASE WHEN status = 'completed' THEN 'processing' ELSE status END,
+	                              completed_at = NULL
+	                          WHERE id = $1 AND status <> 'cancelled'`, batchID.Hex(), len(items))
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.New("queue batch not found or cancelled")
+	}
+	if err := copyQueueItems(ctx, tx, items); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *queueRepository) GetBatch(ctx context.Context, accountID string, id primitive.ObjectID) (*models.QueueBatch, error) {
+	query := `SELECT id, account_id, campaign_id, status, item_count, processed_count, success_count, failed_count,
+	                 created_at, started_at, completed_at, worker_id
+	          FROM send_queue_batches WHERE id = $1 AND account_id = $2`
+	var b models.QueueBatch
+	var batchID, status string
+	var campaignID *string
+	var startedAt, completedAt *time.Time
+	err := r.db.QueryRow(ctx, query, id.Hex(), accountID).Scan(&batchID, &b.AccountID, &campaignID, &status, &b.ItemCount,
+		&b.ProcessedCount, &b.SuccessCount, &b.FailedCount, &b.CreatedAt, &startedAt, &completedAt, &b.WorkerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.New("queue batch not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	b.ID, _ = primitive.ObjectIDFromHex(batchID)
+	b.CampaignID = nullToOID(campaignID)
+	b.Status = models.QueueStatus(status)
+	if startedAt != nil {
+		b.StartedAt = *startedAt
+	}
+	if completedAt != nil {
+		b.CompletedAt = *completedAt
+	}
+	return &b, nil
+}
+
+// LeaseItems: آیتم Pending سررسید یا آیتم Processing با Lease منقضی (Worker از کار افتاده) برداشته می‌شود؛
+// Lease منقضی یک تلاش ناموفق حساب می‌شود تا آیتمی که Worker را از کار می‌اندازد بی‌پایان تکرار نشود
+func (r *queueRepository) LeaseItems(ctx context.Context, workerID string, now time.Time, limit int, visibility time.Duration) ([]*models.QueueItem, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `UPDATE send_queue_items
+	          SET retry_count = retry_count + CASE WHEN status = 'processing' THEN 1 ELSE 0 END,
+	              last_error = CASE WHEN status = 'processing' THEN 'lease expired' ELSE last_error END,
+	              error_history = CASE WHEN status = 'processing'
+	                  THEN error_history || jsonb_build_array(jsonb_build_object('attempt', retry_count + 1, 'error', 'lease expired',
+	                                                                             'worker_id', locked_by, 'at', processed_at))
+	                  ELSE error_history END,
+	              status = 'processing', processed_at = $2, locked_by = $1, locked_until = $3
+	          WHERE id IN (
+	              SELECT id FROM send_queue_items
+	              WHERE (status = 'pending' AND scheduled_at <= $2) OR (status = 'processing' AND locked_until < $2)
+	              ORDER BY priority DESC, scheduled_at
+	              LIMIT $4
+	              FOR UPDATE SKIP LOCKED
+	          )
+	          RETURNING ` + queueItemColumns
+	rows, err := tx.Query(ctx, query, workerID, now, now.Add(visibility), limit)
+	if err != nil {
+		return nil, err
+	}
+	var items []*models.QueueItem
+	batches := make(map[string]bool)
+	for rows.Next() {
+		item, err := scanQueueItem(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		items = append(items, item)
+		batches[item.BatchID.Hex()] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(batches) > 0 {
+		ids := make([]string, 0, len(batches))
+		for id := range batches {
+			ids = append(ids, id)
+		}
+		if _, err := tx.Exec(ctx, `UPDATE send_queue_batches SET status = 'processing', started_at = $2, worker_id = $3
+		                          WHERE id = ANY($1) AND status = 'pending'`, ids, now, workerID); err != nil {
+			return nil, err
+		}
+	}
+	return items, tx.Commit(ctx)
+}
+
+func (r *queueRepository) CompleteItem(ctx context.Context, id primitive.ObjectID, workerID, externalID string, now time.Time) error {
+	return r.finish(ctx, id, workerID, now, 1, 0, `UPDATE send_queue_items
+	        SET status = 'completed', completed_at = $3, external_id = $4, last_error = '', locked_by = NULL, locked_until = NULL
+	        WHERE id = $1 AND locked_by = $2 AND status = 'processing'
+	        RETURNING batch_id`, externalID)
+}
+
+// FailItem؛ Payload صف مرده همان ردیف آیتم (to_jsonb) است که کلیدهای آن با تگ‌های json مدل QueueItem یکی هستند
+func (r *queueRepository) FailItem(ctx context.Context, id primitive.ObjectID, workerID, lastError, errorClass string, now time.Time) error {
+	return r.finish(ctx, id, workerID, now, 0, 1, `WITH failed AS (
+	            UPDATE send_queue_items
+	            SET status = 'failed', completed_at = $3, last_error = $4, error_history = error_history || `+attemptEntry("$4")+`,
+	                locked_by = NULL, locked_until = NULL
+	            WHERE id = $1 AND locked_by = $2 AND status = 'processing'
+	            RETURNING *
+	        ), dead AS (
+	            INSERT INTO dead_letters (id, account_id, campaign_id, batch_id, item_id, recipient_email, error_class, last_error,
+	                                      attempts, payload, status, dead_at)
+	            SELECT $5, account_id, campaign_id, batch_id, id, recipient_email, $6, last_error,
+	                   retry_count + 1, to_jsonb(failed) - 'locked_by' - 'locked_until', 'dead', $3
+	            FROM failed
+	        )
+	        SELECT batch_id FROM failed`, lastError, primitive.NewObjectID().Hex(), errorClass)
+}
+
+// SkipItem گیرنده Suppress شده؛ آیتم Cancelled می‌شود و دلیل در campaign_suppressions ثبت می‌شود (یک تراکنش)
+func (r *queueRepository) SkipItem(ctx context.Context, id primitive.ObjectID, workerID, reason string, now time.Time) error {
+	return r.finish(ctx, id, workerID, now, 0, 0, `WITH skipped AS (
+	            UPDATE send_queue_items
+	            SET status = 'cancelled', completed_at = $3, last_error = 'suppressed: ' || $4::text, locked_by = NULL, locked_until = NULL
+	            WHERE id = $1 AND locked_by = $2 AND status = 'processing'
+	            RETURNING *
+	        ), recorded AS (
+	            INSERT INTO campaign_suppressions (item_id, account_id, campaign_id, recipient_email, reason, created_at)
+	            SELECT id, account_id, COALESCE(NULLIF(campaign_ref, ''), campaign_id, ''), recipient_email, $4, $3
+	            FROM skipped
+	            ON CONFLICT (item_id) DO NOTHING
+	        )
+	        SELECT batch_id FROM skipped`, reason)
+}
+
+func (r *queueRepository) RetryItem(ctx context.Context, id primitive.ObjectID, workerID, lastError string, retryAt time.Time) error {
+	tag, err := r.db.Exec(ctx, `UPDATE send_queue_items
+	        SET status = 'pending', retry_count = retry_count + 1, last_error = $3, scheduled_at = $4,
+	            error_history = error_history || `+attemptEntry("$3")+`, locked_by = NULL, locked_until = NULL
+	        WHERE id = $1 AND locked_by = $2 AND status = 'processing'`, id.Hex(), workerID, lastError, retryAt)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errQueueLeaseLost
+	}
+	return nil
+}
+
+func (r *queueRepository) DeferItem(ctx context.Context, id primitive.ObjectID, workerID string, until time.Time) error {
+	tag, err := r.db.Exec(ctx, `UPDATE send_queue_items
+	        SET status = 'pending', scheduled_at = $3, locked_by = NULL, locked_until = NULL
+	        WHERE id = $1 AND locked_by = $2 AND status = 'processing'`, id.Hex(), workerID, until)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errQueueLeaseLost
+	}
+	return nil
+}
+
+func (r *queueRepository) PendingItems(ctx context.Context, accountID, campaignRef string) (int, error) {
+	var n int
+	err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM send_queue_items
+	        WHERE account_id = $1 AND campaign_ref = $2 AND status IN ('pending', 'processing')`, accountID, campaignRef).Scan(&n)
+	return n, err
+}
+
+// ---------------------------------------------------------
+// توابع کمکی
+// ---------------------------------------------------------
+
+// finish وضعیت نهایی آیتم و شمارنده‌های دسته در یک تراکنش؛ با آخرین آیتم دسته Completed می‌شود
+// آیتم رد شده (Suppression) پردازش شده حساب می‌شود ولی در موفق و ناموفق شمرده نمی‌شود
+// پارامترهای itemQuery: $1 شناسه آیتم، $2 workerID، $3 now و بعد از آن args
+func (r *queueRepository) finish(ctx context.Context, id primitive.ObjectID, workerID string, now time.Time, successInc, failedInc int,
+	itemQuery string, args ...any) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var batchID string
+	err = tx.QueryRow(ctx, itemQuery, append([]any{id.Hex(), workerID, now}, args...)...).Scan(&batchID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return errQueueLeaseLost
+	}
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE send_queue_batches
+	          SET processed_count = processed_count + 1,
+	              success_count = success_count + $2,
+	              failed_count = failed_count + $3,
+	              status = CASE WHEN processed_count + 1 >= item_count AND status <> 'cancelled' THEN 'completed' ELSE status END,
+	              completed_at = CASE WHEN processed_count + 1 >= item_count THEN $4 ELSE completed_at END
+	          WHERE id = $1`
+	if _, err := tx.Exec(ctx, query, batchID, successInc, failedInc, now); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// attemptEntry ورودی تاریخچه خطا برای تلاش فعلی (errorParam پارامتر متن خطا، workerID همیشه $2)؛
+// زمان تلاش همان زمان Lease آیتم است
+func attemptEntry(errorParam string) string {
+	return `jsonb_build_array(jsonb_build_object(
+	    'attempt', retry_count + 1, 'error', ` + errorParam + `::text, 'worker_id', $2::text, 'at', processed_at))`
+}
+
+func insertQueueBatch(ctx context.Context, tx pgx.Tx, b *models.QueueBatch, items []*models.QueueItem) error {
+	query := `INSERT INTO send_queue_batches (id, account_id, campaign_id, status, item_count, created_at)
+	          VALUES ($1, $2, $3, $4, $5, $6)`
+	if _, err := tx.Exec(ctx, query, b.ID.Hex(), b.AccountID, oidToNull(b.CampaignID), string(b.Status), len(items), b.CreatedAt); err != nil {
+		return err
+	}
+	if err := copyQueueItems(ctx, tx, items); err != nil {
+		return err
+	}
+	b.ItemCount = len(items)
+	return nil
+}
+
+func copyQueueItems(ctx context.Context, tx pgx.Tx, items []*models.QueueItem) error {
+	columns := []string{"id", "batch_id", "account_id", "campaign_id", "message_id", "recipient_email", "recipient_data",
+		"status", "priority", "created_at", "scheduled_at", "retry_count", "max_retries",
+		"queue_name", "exchange", "routing_key", "server", "error_history", "sending_domain", "campaign_ref"}
+	rows := make([][]any, 0, len(items))
+	for _, it := range items {
+		data, err := json.Marshal(it.RecipientData)
+		if err != nil {
+			return err
+		}
+		history, err := json.Marshal(it.ErrorHistory)
+		if err != nil {
+			return err
+		}
+		if it.ErrorHistory == nil {
+			history = []byte("[]")
+		}
+		rows = append(rows, []any{it.ID.Hex(), it.BatchID.Hex(), it.AccountID, oidToNull(it.CampaignID), oidToNull(it.MessageID),
+			it.RecipientEmail, data, string(it.Status), int(it.Priority), it.CreatedAt, it.ScheduledAt, it.RetryCount, it.MaxRetries,
+			it.QueueName, it.Exchange, it.RoutingKey, it.Server, history, it.SendingDomain, it.CampaignRef})
+	}
+	_, err := tx.CopyFrom(ctx, pgx.Identifier{"send_queue_items"}, columns, pgx.CopyFromRows(rows))
+	return err
+}
+
+func scanQueueItem(row pgx.Row) (*models.QueueItem, error) {
+	var it models.QueueItem
+	var id, batchID, status string
+	var campaignID, messageID *string
+	var priority int
+	var data, history []byte
+	var processedAt, completedAt *time.Time
+	if err := row.Scan(&id, &batchID, &it.AccountID, &campaignID, &messageID, &it.RecipientEmail, &data, &status, &priority,
+		&it.CreatedAt, &it.ScheduledAt, &processedAt, &completedAt, &it.RetryCount, &it.MaxRetries, &it.LastError, &it.ExternalID,
+		&it.QueueName, &it.Exchange, &it.RoutingKey, &it.Server, &history, &it.SendingDomain, &it.CampaignRef); err != nil {
+		return nil, err
+	}
+	it.ID, _ = primitive.ObjectIDFromHex(id)
+	it.BatchID, _ = primitive.ObjectIDFromHex(batchID)
+	it.CampaignID = nullToOID(campaignID)
+	it.MessageID = nullToOID(messageID)
+	it.Status = models.QueueStatus(status)
+	it.Priority = models.QueuePriority(priority)
+	if processedAt != nil {
+		it.ProcessedAt = *processedAt
+	}
+	if completedAt != nil {
+		it.CompletedAt = *completedAt
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &it.RecipientData); err != nil {
+			return nil, err
+		}
+	}
+	if len(history) > 0 {
+		if err := json.Unmarshal(history, &it.ErrorHistory); err != nil {
+			return nil, err
+		}
+	}
+	return &it, nil
+}
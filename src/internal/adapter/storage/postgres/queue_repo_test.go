@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestQueueLease(t *testing.T) {
+	db := testPool(t)
+	repo := NewQueueRepository(db)
+	ctx := context.Background()
+
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	batch := &models.QueueBatch{ID: primitive.NewObjectID(), AccountID: primitive.NewObjectID().Hex(),
+		Status: models.QUEUE_PENDING, CreatedAt: now}
+	var items []*models.QueueItem
+	for _, email := range []string{"a@example.com", "b@example.com"} {
+		// اولویت بالا و زمان قدیمی تا آیتم‌های آزمون پیش از ردیف‌های دیگر پایگاه داده برداشته شوند
+		items = append(items, &models.QueueItem{ID: primitive.NewObjectID(), BatchID: batch.ID, AccountID: batch.AccountID,
+			RecipientEmail: email, Status: models.QUEUE_PENDING, Priority: models.PRIORITY_URGENT,
+			CreatedAt: now, ScheduledAt: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)})
+	}
+	cleanup(t, db, `DELETE FROM send_queue_batches WHERE id = $1`, batch.ID.Hex())
+	if err := repo.CreateBatch(ctx, batch, items); err != nil {
+		t.Fatal(err)
+	}
+
+	ours := func(leased []*models.QueueItem) map[primitive.ObjectID]*models.QueueItem {
+		m := make(map[primitive.ObjectID]*models.QueueItem)
+		for _, it := range leased {
+			if it.BatchID == batch.ID {
+				m[it.ID] = it
+			}
+		}
+		return m
+	}
+
+	leased, err := repo.LeaseItems(ctx, "worker-a", now, 2, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ours(leased); len(got) != 2 {
+		t.Fatalf("worker-a leased %d test items, want 2", len(got))
+	}
+	// Lease معتبر: Worker دیگر آیتم‌ها را برنمی‌دارد و آنها را تمام نمی‌کند
+	if leased, err = repo.LeaseItems(ctx, "worker-b", now.Add(30*time.Second), 2, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if got := ours(leased); len(got) != 0 {
+		t.Fatalf("worker-b leased %d items under a live lease", len(got))
+	}
+	if err := repo.CompleteItem(ctx, items[1].ID, "worker-b", "ext-b", now); !errors.Is(err, errQueueLeaseLost) {
+		t.Fatalf("CompleteItem by another worker = %v, want errQueueLeaseLost", err)
+	}
+	if err := repo.CompleteItem(ctx, items[0].ID, "worker-a", "ext-a", now); err != nil {
+		t.Fatal(err)
+	}
+
+	// Lease منقضی: آیتم دوباره برداشته می‌شود و یک تلاش ناموفق حساب می‌شود
+	if leased, err = repo.LeaseItems(ctx, "worker-b", now.Add(2*time.Minute), 2, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	it := ours(leased)[items[1].ID]
+	if it == nil {
+		t.Fatal("expired lease was not taken over")
+	}
+	if it.RetryCount != 1 || it.LastError != "lease expired" || len(it.ErrorHistory) != 1 {
+		t.Errorf("taken over item: retry_count = %d, last_error = %q, history = %d; want 1, lease expired, 1",
+			it.RetryCount, it.LastError, len(it.ErrorHistory))
+	}
+	if err := repo.FailItem(ctx, items[1].ID, "worker-a", "boom", "permanent", now); !errors.Is(err, errQueueLeaseLost) {
+		t.Fatalf("FailItem by the expired worker = %v, want errQueueLeaseLost", err)
+	}
+	if err := repo.CompleteItem(ctx, items[1].ID, "worker-b", "ext-b", now.Add(2*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := repo.GetBatch(ctx, batch.AccountID, batch.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Status != models.QUEUE_COMPLETED || b.ProcessedCount != 2 || b.SuccessCount != 2 || b.FailedCount != 0 {
+		t.Errorf("GetBatch() = status %s, processed %d, success %d, failed %d; want completed, 2, 2, 0",
+			b.Status, b.ProcessedCount, b.SuccessCount, b.FailedCount)
+	}
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// گیرندگان رد شده هر کمپین توسط queueRepository.SkipItem درج می‌شوند
+type suppressionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSuppressionRepository(db *pgxpool.Pool) port.ISuppressionRepository {
+	return &suppressionRepository{db: db}
+}
+
+func (r *suppressionRepository) ListGlobalSuppressions(ctx context.Context, since time.Time) ([]domain.Suppression, error) {
+	query := `SELECT email, reason, created_at FROM global_suppressions
+	          WHERE ($1::timestamptz IS NULL OR created_at > $1) ORDER BY created_at`
+	rows, err := r.db.Query(ctx, query, zeroTimeToNull(since))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []domain.Suppression
+	for rows.Next() {
+		var s domain.Suppression
+		if err := rows.Scan(&s.Email, &s.Reason, &s.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, s)
+	}
+	return items, rows.Err()
+}
+
+func (r *suppressionRepository) AddGlobalSuppressions(ctx context.Context, items []domain.Suppression) error {
+	if len(items) == 0 {
+		return nil
+	}
+	emails := make([]string, len(items))
+	reasons := make([]string, len(items))
+	createdAt := make([]time.Time, len(items))
+	for i, s := range items {
+		emails[i], reasons[i], createdAt[i] = s.Email, s.Reason, s.CreatedAt
+	}
+	query := `INSERT INTO global_suppressions (email, reason, created_at)
+	          SELECT * FROM unnest($1::text[], $2::text[], $3::timestamptz[])
+	          ON CONFLICT (email) DO NOTHING`
+	_, err := r.db.Exec(ctx, query, emails, reasons, createdAt)
+	return err
+}
+
+// دامنه‌های حذف تکرار رویدادها در processed_email_events؛ هر شمارنده شناسه رویداد را در تراکنش خودش ثبت می‌کند
+const (
+	eventScopeSoftBounce    = "soft_bounce"
+	eventScopeDomainStats   = "domain_stats"
+	eventScopeCampaignStats = "campaign_stats"
+)
+
+// RecordSoftBounce: رویداد تکراری شمارنده را تغییر نمی‌دهد و فقط مقدار فعلی آن برگردانده می‌شود
+func (r *suppressionRepository) RecordSoftBounce(ctx context.Context, eventID, accountID, email string, at time.Time, window time.Duration) (int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var count int
+	if eventID != "" {
+		tag, err := tx.Exec(ctx, `INSERT INTO processed_email_events (event_id, scope) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+			eventID, eventScopeSoftBounce)
+		if err != nil {
+			return 0, err
+		}
+		if tag.RowsAffected() == 0 {
+			err := tx.QueryRow(ctx, `SELECT count FROM soft_bounces WHERE account_id = $1 AND email = $2`, accountID, email).Scan(&count)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return 0, nil
+			}
+			return count, err
+		}
+	}
+
+	query := `INSERT INTO soft_bounces (account_id, email, count, first_at, last_at)
+	          VALUES ($1, $2, 1, $3, $3)
+	          ON CONFLICT (account_id, email) DO UPDATE SET
+	              count = CASE WHEN soft_bounces.first_at < $3 - $4::float8 * INTERVAL '1 second' THEN 1 ELSE soft_bounces.count + 1 END,
+	              first_at = CASE WHEN soft_bounces.first_at < $3 - $4::float8 * INTERVAL '1 second' THEN $3 ELSE soft_bounces.first_at END,
+	              last_at = $3
+	          RETURNING count`
+	if err := tx.QueryRow(ctx, query, accountID, email, at, window.Seconds()).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, tx.Commit(ctx)
+}
+
+func (r *suppressionRepository) ResetSoftBounces(ctx context.Context, accountID string, emails []string) error {
+	if len(emails) == 0 {
+		return nil
+	}
+	_, err := r.db.Exec(ctx, `DELETE FROM soft_bounces WHERE account_id = $1 AND email = ANY($2)`, accountID, emails)
+	return err
+}
+
+func (r *suppressionRepository) PruneProcessedEvents(ctx context.Context, before time.Time) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM processed_email_events WHERE processed_at < $1`, before)
+	return err
+}
+
+func (r *suppressionRepository) SummarizeCampaignSuppressions(ctx context.Context, accountID, campaignID string) ([]domain.SuppressionSummary, error) {
+	query := `SELECT reason, COUNT(*) FROM campaign_suppressions
+	          WHERE account_id = $1 AND campaign_id = $2
+	          GROUP BY reason ORDER BY COUNT(*) DESC`
+	rows, err := r.db.Query(ctx, query, accountID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var summary []domain.SuppressionSummary
+	for rows.Next() {
+		var s domain.SuppressionSummary
+		if err := rows.Scan(&s.Reason, &s.Count); err != nil {
+			return nil, err
+		}
+		summary = append(summary, s)
+	}
+	return summary, rows.Err()
+}
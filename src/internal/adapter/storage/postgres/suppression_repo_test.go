@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestRecordSoftBounce(t *testing.T) {
+	db := testPool(t)
+	repo := NewSuppressionRepository(db)
+	ctx := context.Background()
+
+	accountID := primitive.NewObjectID().Hex()
+	email := "soft@example.com"
+	events := []string{primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex()}
+	cleanup(t, db, `DELETE FROM soft_bounces WHERE account_id = $1`, accountID)
+	cleanup(t, db, `DELETE FROM processed_email_events WHERE event_id = ANY($1)`, events)
+
+	start := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	window := 72 * time.Hour
+	tests := []struct {
+		name    string
+		eventID string
+		at      time.Time
+		want    int
+	}{
+		{name: "first bounce", eventID: events[0], at: start, want: 1},
+		{name: "redelivered event is not counted", eventID: events[0], at: start.Add(time.Minute), want: 1},
+		{name: "second bounce in window", eventID: events[1], at: start.Add(time.Hour), want: 2},
+		{name: "bounce without id is always counted", eventID: "", at: start.Add(2 * time.Hour), want: 3},
+		{name: "bounce after the window restarts the count", eventID: events[2], at: start.Add(window + time.Hour), want: 1},
+	}
+	for _, tt := range tests {
+		got, err := repo.RecordSoftBounce(ctx, tt.eventID, accountID, email, tt.at, window)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: RecordSoftBounce() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+
+	if err := repo.ResetSoftBounces(ctx, accountID, []string{email}); err != nil {
+		t.Fatal(err)
+	}
+	// شناسه پردازش شده پس از Reset هم شمرده نمی‌شود و شمارنده حذف شده صفر است
+	if got, err := repo.RecordSoftBounce(ctx, events[2], accountID, email, start, window); err != nil || got != 0 {
+		t.Errorf("after reset: RecordSoftBounce() = %d, %v; want 0, nil", got, err)
+	}
+}
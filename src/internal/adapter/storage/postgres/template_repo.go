@@ -3,6 +3,8 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
 	_ "github.com/jackc/pgx/v5/pgxpool" // برای تبدیل []string به آرایه Postgres
 	"github.com/lib/pq"
@@ -17,32 +19,296 @@ func NewTemplateRepository(db *sql.DB) *templateRepository {
 }
 
 func (r *templateRepository) SaveTemplate(ctx context.Context, t *domain.Template) error {
-	query := `INSERT INTO templates (id, account_id, name, current_version_id, updated_at)
-	          VALUES (COALESCE(NULLIF($1, '')::uuid, gen_random_uuid()), $2, $3, NULLIF($4, '')::uuid, NOW())
-	          ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, current_version_id = EXCLUDED.current_version_id, updated_at = NOW()
-	          RETURNING id, created_at`
-	return r.db.QueryRowContext(ctx, query, t.ID, t.AccountID, t.Name, t.CurrentVersionID).Scan(&t.ID, &t.CreatedAt)
+	query := `INSERT INTO templates (id, account_id, name, current_version_id, default_language, reviewers, updated_at)
+	          VALUES (COALESCE(NULLIF($1, '')::uuid, gen_random_uuid()), $2, $3, NULLIF($4, '')::uuid, COALESCE(NULLIF($5, ''), 'en'), $6, NOW())
+	          ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, current_version_id = EXCLUDED.current_version_id,
+	                                         default_language = EXCLUDED.default_language, reviewers = EXCLUDED.reviewers, updated_at = NOW()
+	          RETURNING id, default_language, created_at`
+	return r.db.QueryRowContext(ctx, query, t.ID, t.AccountID, t.Name, t.CurrentVersionID, t.DefaultLanguage, pq.Array(t.Reviewers)).Scan(&t.ID, &t.DefaultLanguage, &t.CreatedAt)
 }
 
 func (r *templateRepository) SaveVersion(ctx context.Context, v *domain.TemplateVersion) error {
-	query := `INSERT INTO template_versions (template_id, version_label, subject, html_content, plain_text, language, tags, metadata)
-	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8::jsonb) RETURNING id, created_at`
+	query := `INSERT INTO template_versions (template_id, version_label, subject, html_content, plain_text, language, tags, metadata, warnings, block_tags,
+	                                       status, created_by)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8::jsonb, $9, $10, COALESCE(NULLIF($11, ''), 'approved'), $12) RETURNING id, status, created_at`
 	return r.db.QueryRowContext(ctx, query,
 		v.TemplateID, v.VersionLabel, v.Subject, v.HTMLContent, v.PlainText,
-		v.Language, pq.Array(v.Tags), v.Metadata,
-	).Scan(&v.ID, &v.CreatedAt)
+		v.Language, pq.Array(v.Tags), v.Metadata, pq.Array(v.Warnings), pq.Array(v.BlockTags), v.Status, v.CreatedBy,
+	).Scan(&v.ID, &v.Status, &v.CreatedAt)
 }
 
 func (r *templateRepository) GetTemplate(ctx context.Context, accountID, templateID string) (*domain.Template, *domain.TemplateVersion, error) {
 	var t domain.Template
 	var v domain.TemplateVersion
-	query := `SELECT t.id, t.name, t.current_version_id, tv.subject, tv.html_content, tv.tags, tv.metadata
+	var lint []byte
+	// تا تایید اولین نسخه یک قالب تحت بازبینی، current_version_id خالی است
+	query := `SELECT t.id, t.name, COALESCE(t.current_version_id::text, ''), t.default_language, t.reviewers,
+	                 COALESCE(tv.version_label, ''), COALESCE(tv.subject, ''), COALESCE(tv.html_content, ''), COALESCE(tv.plain_text, ''),
+	                 COALESCE(tv.language, ''), tv.tags, COALESCE(tv.metadata::text, ''), tv.warnings, tv.block_tags,
+	                 COALESCE(tv.status, ''), COALESCE(tv.created_by, ''), tv.lint_result
 	          FROM templates t 
 	          LEFT JOIN template_versions tv ON t.current_version_id = tv.id
 	          WHERE t.account_id = $1 AND t.id = $2`
 
 	err := r.db.QueryRowContext(ctx, query, accountID, templateID).Scan(
-		&t.ID, &t.Name, &t.CurrentVersionID, &v.Subject, &v.HTMLContent, pq.Array(&v.Tags), &v.Metadata,
+		&t.ID, &t.Name, &t.CurrentVersionID, &t.DefaultLanguage, pq.Array(&t.Reviewers),
+		&v.VersionLabel, &v.Subject, &v.HTMLContent, &v.PlainText,
+		&v.Language, pq.Array(&v.Tags), &v.Metadata, pq.Array(&v.Warnings), pq.Array(&v.BlockTags),
+		&v.Status, &v.CreatedBy, &lint,
 	)
+	if err != nil {
+		return &t, &v, err
+	}
+	t.AccountID = accountID
+	v.ID = t.CurrentVersionID
+	v.TemplateID = t.ID
+	v.LintResult, err = unmarshalLintResult(lint)
 	return &t, &v, err
 }
+
+func (r *templateRepository) ListTemplates(ctx context.Context, accountID string, limit, offset int32) ([]*domain.Template, int32, error) {
+	query := `SELECT id, name, COALESCE(current_version_id::text, ''), default_language, COALESCE(folder_id, ''), reviewers,
+	                 created_at, updated_at, COUNT(*) OVER()
+	          FROM templates WHERE account_id = $1
+	          ORDER BY updated_at DESC LIMIT $2 OFFSET $3`
+	rows, err := r.db.QueryContext(ctx, query, accountID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var list []*domain.Template
+	var total int32
+	for rows.Next() {
+		t := &domain.Template{AccountID: accountID}
+		if err := rows.Scan(&t.ID, &t.Name, &t.CurrentVersionID, &t.DefaultLanguage, &t.FolderID, pq.Array(&t.Reviewers),
+			&t.CreatedAt, &t.UpdatedAt, &total); err != nil {
+			return nil, 0, err
+		}
+		list = append(list, t)
+	}
+	return list, total, rows.Err()
+}
+
+// DeleteTemplate نسخه‌ها با ON DELETE CASCADE حذف می‌شوند
+func (r *templateRepository) DeleteTemplate(ctx context.Context, accountID, templateID string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM templates WHERE account_id = $1 AND id = $2`, accountID, templateID)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *templateRepository) GetVersion(ctx context.Context, accountID, versionID string) (*domain.TemplateVersion, error) {
+	var v domain.TemplateVersion
+	var lint []byte
+	query := `SELECT tv.id, tv.template_id, tv.version_label, tv.subject, tv.html_content, tv.plain_text,
+	                 tv.language, tv.tags, tv.metadata, tv.warnings, tv.block_tags, tv.status, COALESCE(tv.created_by, ''),
+	                 tv.lint_result, tv.created_at
+	          FROM template_versions tv
+	          JOIN templates t ON t.id = tv.template_id
+	          WHERE t.account_id = $1 AND tv.id = $2`
+
+	err := r.db.QueryRowContext(ctx, query, accountID, versionID).Scan(
+		&v.ID, &v.TemplateID, &v.VersionLabel, &v.Subject, &v.HTMLContent, &v.PlainText,
+		&v.Language, pq.Array(&v.Tags), &v.Metadata, pq.Array(&v.Warnings), pq.Array(&v.BlockTags), &v.Status, &v.CreatedBy,
+		&lint, &v.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	v.LintResult, err = unmarshalLintResult(lint)
+	return &v, err
+}
+
+func (r *templateRepository) SaveLintResult(ctx context.Context, versionID string, result *domain.TemplateLintResult) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, `UPDATE template_versions SET lint_result = $1::jsonb WHERE id = $2`, data, versionID)
+	return err
+}
+
+func (r *templateRepository) SetCurrentVersion(ctx context.Context, templateID, language, versionID string) error {
+	query := `INSERT INTO template_current_versions (template_id, language, version_id, updated_at)
+	          VALUES ($1, $2, $3, NOW())
+	          ON CONFLICT (template_id, language) DO UPDATE SET version_id = EXCLUDED.version_id, updated_at = NOW()`
+	_, err := r.db.ExecContext(ctx, query, templateID, language, versionID)
+	return err
+}
+
+func (r *templateRepository) GetCurrentVersions(ctx context.Context, templateID string) (map[string]string, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT language, version_id FROM template_current_versions WHERE template_id = $1`, templateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	variants := make(map[string]string)
+	for rows.Next() {
+		var lang, versionID string
+		if err := rows.Scan(&lang, &versionID); err != nil {
+			return nil, err
+		}
+		variants[lang] = versionID
+	}
+	return variants, rows.Err()
+}
+
+// ListBlockUsages نسخه‌های فعلی (هر زبان) که بلاک را ارجاع داده‌اند
+func (r *templateRepository) ListBlockUsages(ctx context.Context, accountID, tag string) ([]domain.BlockUsage, error) {
+	query := `SELECT t.id, t.name, tv.id, cv.language
+	          FROM template_current_versions cv
+	          JOIN template_versions tv ON tv.id = cv.version_id
+	          JOIN templates t ON t.id = cv.template_id
+	          WHERE t.account_id = $1 AND $2 = ANY(tv.block_tags)
+	          ORDER BY t.name, cv.language`
+	rows, err := r.db.QueryContext(ctx, query, accountID, tag)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var usages []domain.BlockUsage
+	for rows.Next() {
+		var u domain.BlockUsage
+		if err := rows.Scan(&u.TemplateID, &u.TemplateName, &u.VersionID, &u.Language); err != nil {
+			return nil, err
+		}
+		usages = append(usages, u)
+	}
+	return usages, rows.Err()
+}
+
+// SaveSnapshot اسنپ‌شات موجود همان زبان بازنویسی نمی‌شود
+func (r *templateRepository) SaveSnapshot(ctx context.Context, snap *domain.TemplateSnapshot) error {
+	query := `INSERT INTO template_snapshots (template_id, version_id, campaign_id, language, subject, html_content, plain_text, is_default)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	          ON CONFLICT (campaign_id, template_id, language) DO NOTHING
+	          RETURNING id, created_at`
+	err := r.db.QueryRowContext(ctx, query,
+		snap.TemplateID, snap.VersionID, snap.CampaignID, snap.Language, snap.Subject, snap.HTMLContent, snap.PlainText, snap.IsDefault,
+	).Scan(&snap.ID, &snap.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	return err
+}
+
+func (r *templateRepository) ListSnapshots(ctx context.Context, campaignID, templateID string) ([]*domain.TemplateSnapshot, error) {
+	query := `SELECT id, template_id, version_id, campaign_id, language, COALESCE(subject, ''), html_content, COALESCE(plain_text, ''),
+	                 is_default, created_at
+	          FROM template_snapshots
+	          WHERE campaign_id = $1 AND template_id = $2
+	          ORDER BY is_default DESC, language`
+	rows, err := r.db.QueryContext(ctx, query, campaignID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var snaps []*domain.TemplateSnapshot
+	for rows.Next() {
+		var snap domain.TemplateSnapshot
+		if err := rows.Scan(&snap.ID, &snap.TemplateID, &snap.VersionID, &snap.CampaignID, &snap.Language, &snap.Subject,
+			&snap.HTMLContent, &snap.PlainText, &snap.IsDefault, &snap.CreatedAt); err != nil {
+			return nil, err
+		}
+		snaps = append(snaps, &snap)
+	}
+	return snaps, rows.Err()
+}
+
+func (r *templateRepository) SetVersionStatus(ctx context.Context, versionID, status string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE template_versions SET status = $1 WHERE id = $2`, status, versionID)
+	return err
+}
+
+func (r *templateRepository) SaveReview(ctx context.Context, review *domain.TemplateReview) error {
+	query := `INSERT INTO template_reviews (template_id, version_id, reviewer_id, decision, comment)
+	          VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at`
+	return r.db.QueryRowContext(ctx, query,
+		review.TemplateID, review.VersionID, review.ReviewerID, review.Decision, review.Comment,
+	).Scan(&review.ID, &review.CreatedAt)
+}
+
+func (r *templateRepository) ListReviews(ctx context.Context, accountID, versionID string) ([]domain.TemplateReview, error) {
+	query := `SELECT rv.id, rv.template_id, rv.version_id, rv.reviewer_id, rv.decision, COALESCE(rv.comment, ''), rv.created_at
+	          FROM template_reviews rv
+	          JOIN templates t ON t.id = rv.template_id
+	          WHERE t.account_id = $1 AND rv.version_id = $2
+	          ORDER BY rv.created_at`
+	rows, err := r.db.QueryContext(ctx, query, accountID, versionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []domain.TemplateReview
+	for rows.Next() {
+		var rv domain.TemplateReview
+		if err := rows.Scan(&rv.ID, &rv.TemplateID, &rv.VersionID, &rv.ReviewerID, &rv.Decision, &rv.Comment, &rv.CreatedAt); err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, rv)
+	}
+	return reviews, rows.Err()
+}
+
+// AcquireLock قفل را می‌گیرد یا تمدید می‌کند؛ اگر کاربر دیگری قفل فعال داشته باشد همان قفل برگردانده می‌شود
+func (r *templateRepository) AcquireLock(ctx context.Context, lock *domain.TemplateLock) (*domain.TemplateLock, error) {
+	query := `INSERT INTO template_locks (template_id, locked_by, locked_at, expires_at)
+	          VALUES ($1, $2, $3, $4)
+	          ON CONFLICT (template_id) DO UPDATE SET locked_by = EXCLUDED.locked_by, locked_at = EXCLUDED.locked_at, expires_at = EXCLUDED.expires_at
+	          WHERE template_locks.locked_by = EXCLUDED.locked_by OR template_locks.expires_at < NOW()
+	          RETURNING template_id, locked_by, locked_at, expires_at`
+	var out domain.TemplateLock
+	err := r.db.QueryRowContext(ctx, query, lock.TemplateID, lock.LockedBy, lock.LockedAt, lock.ExpiresAt).Scan(
+		&out.TemplateID, &out.LockedBy, &out.LockedAt, &out.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return r.GetLock(ctx, lock.TemplateID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (r *templateRepository) GetLock(ctx context.Context, templateID string) (*domain.TemplateLock, error) {
+	var lock domain.TemplateLock
+	err := r.db.QueryRowContext(ctx, `SELECT template_id, locked_by, locked_at, expires_at FROM template_locks WHERE template_id = $1`, templateID).Scan(
+		&lock.TemplateID, &lock.LockedBy, &lock.LockedAt, &lock.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &lock, nil
+}
+
+func (r *templateRepository) ReleaseLock(ctx context.Context, templateID, userID string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM template_locks WHERE template_id = $1 AND (locked_by = $2 OR expires_at < NOW())`, templateID, userID)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return errors.New("template is not locked by this user")
+	}
+	return nil
+}
+
+func unmarshalLintResult(data []byte) (*domain.TemplateLintResult, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var res domain.TemplateLintResult
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// testDBEnv آدرس پایگاه داده آزمون که مایگریشن‌های پوشه migrations روی آن اجرا شده‌اند؛ بدون آن آزمون‌های مخزن رد می‌شوند
+const testDBEnv = "CAMPAIGN_TEST_DATABASE_URL"
+
+func testPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	dsn := os.Getenv(testDBEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDBEnv)
+	}
+	pool, err := pgxpool.New(context.Background(), dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pool.Ping(context.Background()); err != nil {
+		pool.Close()
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+// cleanup ردیف‌های ساخته شده در آزمون را پس از پایان آن حذف می‌کند
+func cleanup(t *testing.T, db *pgxpool.Pool, query string, args ...any) {
+	t.Cleanup(func() {
+		if _, err := db.Exec(context.Background(), query, args...); err != nil {
+			t.Errorf("cleanup %q: %v", query, err)
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type trackingRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTrackingRepository(db *pgxpool.Pool) port.ITrackingRepository {
+	return &trackingRepository{db: db}
+}
+
+func (r *trackingRepository) RecordHit(ctx context.Context, h *domain.TrackingHit) error {
+	query := `INSERT INTO tracking_hits
+	              (account_id, campaign_id, email, event_type, url, ip_address, user_agent, machine, machine_reason, created_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	_, err := r.db.Exec(ctx, query, h.AccountID, h.CampaignID, h.Email, h.Type, h.URL,
+		h.IPAddress, h.UserAgent, h.Machine, h.MachineReason, h.At)
+	return err
+}
@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type unsubscribeRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewUnsubscribeRepository(db *pgxpool.Pool) port.IUnsubscribeRepository {
+	return &unsubscribeRepository{db: db}
+}
+
+func (r *unsubscribeRepository) RecordUnsubscribe(ctx context.Context, u *domain.Unsubscribe) error {
+	query := `INSERT INTO campaign_unsubscribes
+	              (account_id, campaign_id, list_id, email, source, ip_address, user_agent, created_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	          ON CONFLICT (account_id, campaign_id, email) DO NOTHING`
+	_, err := r.db.Exec(ctx, query, u.AccountID, u.CampaignID, u.ListID, u.Email, u.Source, u.IPAddress, u.UserAgent, u.CreatedAt)
+	return err
+}
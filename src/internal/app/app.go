@@ -1,73 +1,223 @@
 package app
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"net"
+	"os"
+	"sync"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/jackc/pgx/v5/pgxpool" // درایور جدید
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
 
 	"github.com/ehsanshah/campaign-services/src/configs"
+	"github.com/ehsanshah/campaign-services/src/internal/adapter/broker"
+	"github.com/ehsanshah/campaign-services/src/internal/adapter/grpcclient"
 	grpcHandler "github.com/ehsanshah/campaign-services/src/internal/adapter/handler/grpc"
+	"github.com/ehsanshah/campaign-services/src/internal/adapter/handler/httpserver"
+	"github.com/ehsanshah/campaign-services/src/internal/adapter/httpclient"
 	"github.com/ehsanshah/campaign-services/src/internal/adapter/storage/postgres"
-	"github.com/ehsanshah/campaign-services/src/internal/service"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	services "github.com/ehsanshah/campaign-services/src/internal/service"
 
 	// مسیر کدهای جنریت شده پروتو
 	pb "github.com/ehsanshah/campaign-services/src/pkg/pb/camp/v1"
+)
 
-	// پکیج اتصال دیتابیس که ساختیم
-	pkgPostgres "github.com/ehsanshah/campaign-services/src/internal/adapter/storage/postgres"
+// مقادیر پیش‌فرض SendingConfig
+const (
+	defaultSendWorkers   = 8
+	defaultDispatchEvery = 30 * time.Second
+	defaultEventsQueue   = "campaign.delivery_events"
+	defaultEventsBinding = "email.event.#"
 )
 
 // App تمام وابستگی‌های سطح بالای سرویس را نگه می‌دارد
 type App struct {
 	Cfg        *configs.Config
 	GRPCServer *grpc.Server
+	HTTPServer *httpserver.Server
 	DB         *pgxpool.Pool // استفاده از Pool قدرتمند pgx
+	Broker     port.MessageBroker
+
+	sqlDB      *sql.DB // همان Pool برای مخزن‌های database/sql و sqlx
+	conns      []*grpc.ClientConn
+	sendQueue  port.ISendQueue
+	sender     port.ICampaignSender
+	dispatcher port.ICampaignDispatcher
+	events     port.IDeliveryEventService
+	engine     port.IAutomationEngine
+	triggers   port.IAutomationTriggerService
+
+	ctx    context.Context // عمر کارهای پس‌زمینه؛ Shutdown آن را لغو می‌کند
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
 }
 
 // NewApp وظیفه سیم‌کشی (Wiring) و Dependency Injection را دارد
 func NewApp(cfg *configs.Config) (*App, error) {
+	a := &App{Cfg: cfg}
+	a.ctx, a.cancel = context.WithCancel(context.Background())
 
 	// 1. اتصال به دیتابیس (با استفاده از پکیج pkg/postgres)
-	dbPool, err := pkgPostgres.NewConnection(cfg.Postgres)
+	dbPool, err := postgres.NewConnection(cfg.Postgres)
 	if err != nil {
+		a.cancel()
 		return nil, fmt.Errorf("failed to init db connection: %w", err)
 	}
+	a.DB = dbPool
+	a.sqlDB = stdlib.OpenDBFromPool(dbPool)
+	sqlxDB := sqlx.NewDb(a.sqlDB, "pgx")
+
+	// 2. Message Broker و اتصال به سرویس‌های وابسته
+	if a.Broker, err = broker.NewBroker(cfg.Broker); err != nil {
+		a.Shutdown()
+		return nil, fmt.Errorf("failed to init broker: %w", err)
+	}
+	mtaConn, err := a.dial(cfg.Clients.MtaAddress)
+	if err != nil {
+		a.Shutdown()
+		return nil, fmt.Errorf("failed to dial mta service: %w", err)
+	}
+	audienceConn, err := a.dial(cfg.Clients.AudienceAddress)
+	if err != nil {
+		a.Shutdown()
+		return nil, fmt.Errorf("failed to dial audience service: %w", err)
+	}
+	reportsConn, err := a.dial(cfg.Clients.ReportsAddress)
+	if err != nil {
+		a.Shutdown()
+		return nil, fmt.Errorf("failed to dial reports service: %w", err)
+	}
+	mta := grpcclient.NewMtaClient(mtaConn)
+	audience := grpcclient.NewAudienceClient(audienceConn)
+	suppressionClient := grpcclient.NewSuppressionClient(audienceConn)
+	eventsClient := grpcclient.NewEventsClient(reportsConn)
 
-	// 2. راه‌اندازی لایه‌ها (Repo -> Service -> Handler)
+	// 3. راه‌اندازی لایه‌ها (Repo -> Service -> Handler)
 
 	// مخزن داده (Repository)
-	campaignRepo := postgres.NewCampaignRepo(dbPool)
+	campaignAdRepo := postgres.NewCampaignRepo(dbPool)
+	campaignRepo := postgres.NewCampaignRepository(sqlxDB)
+	templateRepo := postgres.NewTemplateRepository(a.sqlDB)
+	blockRepo := postgres.NewContentBlockRepository(a.sqlDB)
+	queueRepo := postgres.NewQueueRepository(dbPool)
+	deadLetterRepo := postgres.NewDeadLetterRepository(dbPool)
+	suppressionRepo := postgres.NewSuppressionRepository(dbPool)
+	throttleRepo := postgres.NewDomainThrottleRepository(dbPool)
+	unsubscribeRepo := postgres.NewUnsubscribeRepository(dbPool)
+	trackingRepo := postgres.NewTrackingRepository(dbPool)
+	automationRepo := postgres.NewAutomationRepository(dbPool)
+	journeyRepo := postgres.NewJourneyRepository(dbPool)
+	automationStatsRepo := postgres.NewAutomationStatsRepository(dbPool)
+	messageRepo := postgres.NewMessageRepository(dbPool)
+	folderRepo := postgres.NewFolderRepository(dbPool)
 
 	// بیزینس لاجیک (Service)
-	campaignService := service.NewCampaignService(campaignRepo)
+	// بررسی لینک‌ها از شبکه اختیاری است و فقط در LintTemplate انجام می‌شود؛ زمان‌بندی کمپین و بلاک‌ها از Lint آفلاین
+	// (یا نتیجه ذخیره شده LintTemplate) استفاده می‌کنند تا لینک‌های کند زمان‌بندی را معطل نکنند
+	var resolver port.ILinkResolver
+	if cfg.Lint.ResolveLinks {
+		resolver = httpclient.NewLinkResolver(cfg.Lint.ResolveTimeout)
+	}
+	linter := services.NewTemplateLinter(nil)
+	blocks := services.NewContentBlockServices(blockRepo, templateRepo, linter)
+	templates := services.NewTemplateServices(templateRepo, services.NewTemplateProcessor(domain.TemplateProcessingOptions{
+		InlineCSS: true, GeneratePlainText: true, Minify: true,
+	}), services.NewTemplateLinter(resolver), blocks)
+	campaignAdService := services.NewCampaignAdService(campaignAdRepo)
+	campaignService := services.NewCampaignServiceMta(campaignRepo, templateRepo, linter, blocks, eventsClient)
+	automationService := services.NewAutomationServices(automationRepo, journeyRepo, automationStatsRepo, campaignRepo)
+	journeyService := services.NewJourneyServices(automationRepo, journeyRepo, automationStatsRepo)
+	folderService := services.NewFolderServices(folderRepo)
+	deadLetterService := services.NewDeadLetterServices(deadLetterRepo)
 
-	// هندلر gRPC
-	campaignHandler := grpcHandler.NewServer(campaignService)
+	suppressions := services.NewSuppressionService(suppressionClient, suppressionRepo)
+	throttle := services.NewDomainThrottle(throttleRepo)
+	a.events = services.NewDeliveryEventService(campaignRepo, suppressionClient, suppressionRepo, suppressions, throttle,
+		cfg.Sending.SoftBounceLimit, cfg.Sending.SoftBounceWindow)
+	unsubscribe, err := services.NewUnsubscribeService(cfg.Tracking.BaseURL, cfg.Tracking.SigningKey, unsubscribeRepo, a.events)
+	if err != nil {
+		a.Shutdown()
+		return nil, fmt.Errorf("failed to init unsubscribe service: %w", err)
+	}
+
+	// پایپ‌لاین ارسال: dispatcher گیرندگان کمپین‌ها و موتور اتوماسیون ایمیل مراحل را به صف می‌دهند و sender هر آیتم را
+	// رندر و به MTA می‌فرستد
+	workerID := cfg.Sending.WorkerID
+	if workerID == "" {
+		host, _ := os.Hostname()
+		workerID = fmt.Sprintf("%s-%d", host, os.Getpid())
+	}
+	a.sendQueue = services.NewSendQueue(queueRepo, throttle, suppressions, workerID)
+	a.engine = services.NewAutomationEngine(automationRepo, journeyRepo, automationStatsRepo, a.sendQueue, workerID)
+	a.triggers = services.NewAutomationTriggerService(automationRepo, journeyRepo, automationStatsRepo, a.engine, audience)
 
-	// 3. راه‌اندازی سرور gRPC
+	tracking, err := services.NewTrackingService(cfg.Tracking.BaseURL, cfg.Tracking.SigningKey, trackingRepo, a.events, a.engine)
+	if err != nil {
+		a.Shutdown()
+		return nil, fmt.Errorf("failed to init tracking service: %w", err)
+	}
+
+	a.sender = services.NewCampaignSender(campaignRepo, templates, mta, unsubscribe, tracking, automationRepo, messageRepo)
+	a.dispatcher = services.NewCampaignDispatcher(campaignRepo, templates, audience, a.sendQueue, eventsClient)
+
+	// 4. راه‌اندازی سرور gRPC
 	grpcServer := grpc.NewServer()
 
 	// ثبت سرویس با نام جدید CampaignServiceAd
-	pb.RegisterCampaignServiceAdServer(grpcServer, campaignHandler)
+	pb.RegisterCampaignServiceAdServer(grpcServer, grpcHandler.NewServer(campaignAdService))
+	pb.RegisterCampaignsMtaServiceServer(grpcServer, grpcHandler.NewCampaignMtaHandler(campaignService))
+	pb.RegisterCampaignReportServiceServer(grpcServer, grpcHandler.NewCampaignReportHandler(campaignService))
+	pb.RegisterITemplateServicesServer(grpcServer, grpcHandler.NewTemplateHandler(templates))
+	pb.RegisterFolderServiceServer(grpcServer, grpcHandler.NewFolderHandler(folderService))
+	pb.RegisterAutomationServiceServer(grpcServer, grpcHandler.NewAutomationHandler(automationService, a.triggers))
+	pb.RegisterAutomationReportServiceServer(grpcServer, grpcHandler.NewAutomationReportHandler(automationService))
+	pb.RegisterJourneyServiceServer(grpcServer, grpcHandler.NewJourneyHandler(journeyService))
+	pb.RegisterDeadLetterServiceServer(grpcServer, grpcHandler.NewDeadLetterHandler(deadLetterService))
 
 	// فعال‌سازی Reflection (برای ابزارهایی مثل Postman/gRPCurl)
 	reflection.Register(grpcServer)
+	a.GRPCServer = grpcServer
 
-	// بازگرداندن ساختار App
-	return &App{
-		Cfg:        cfg,
-		GRPCServer: grpcServer,
-		DB:         dbPool,
-	}, nil
+	// 5. سرور HTTP عمومی (لینک‌های لغو عضویت و رهگیری)
+	if a.HTTPServer, err = httpserver.NewServer(cfg.Server); err != nil {
+		a.Shutdown()
+		return nil, fmt.Errorf("failed to init http server: %w", err)
+	}
+	httpserver.NewUnsubscribeHandler(unsubscribe).Register(a.HTTPServer)
+	httpserver.NewTrackingHandler(tracking).Register(a.HTTPServer)
+
+	return a, nil
 }
 
-// Run سرور را روی پورت مشخص شده اجرا می‌کند (Blocking)
+// dial اتصال gRPC بدون TLS به سرویس داخلی؛ اتصال واقعی در اولین فراخوانی برقرار می‌شود
+func (a *App) dial(address string) (*grpc.ClientConn, error) {
+	if address == "" {
+		return nil, errors.New("address is not configured")
+	}
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	a.conns = append(a.conns, conn)
+	return conn, nil
+}
+
+// Run سرورهای gRPC و HTTP و پایپ‌لاین ارسال را اجرا می‌کند (Blocking)؛ خطای هر یک از سرورها برگردانده می‌شود
 func (a *App) Run() error {
+	ctx := a.ctx
+
 	// ساخت آدرس پورت (مثلا :50054)
 	port := fmt.Sprintf(":%s", a.Cfg.Grpc.Port)
 
@@ -76,17 +226,96 @@ func (a *App) Run() error {
 		return fmt.Errorf("failed to listen on port %s: %w", port, err)
 	}
 
-	log.Printf("🚀 Campaign Service (Ad/MTA) is running on port %s", port)
+	errCh := make(chan error, 2)
+	a.goRun("http server", func() error {
+		if err := a.HTTPServer.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			errCh <- fmt.Errorf("http server: %w", err)
+		}
+		return nil
+	})
+
+	// پایپ‌لاین ارسال و مصرف رویدادهای تحویل
+	cfg := a.Cfg.Sending
+	workers := cfg.Workers
+	if workers <= 0 {
+		workers = defaultSendWorkers
+	}
+	every := cfg.DispatchEvery
+	if every <= 0 {
+		every = defaultDispatchEvery
+	}
+	a.goRun("send queue", func() error { return a.sendQueue.Run(ctx, a.sender.Handle, workers) })
+	a.goRun("campaign dispatcher", func() error { return a.dispatcher.Run(ctx, every) })
+	a.goRun("automation engine", func() error { return a.engine.Run(ctx) })
+	a.goRun("automation date triggers", func() error { return a.triggers.RunDailySweep(ctx) })
+	a.goRun("delivery events", func() error {
+		deliveries, err := a.consumeDeliveryEvents(ctx)
+		if err != nil {
+			return err
+		}
+		return a.events.Consume(ctx, deliveries)
+	})
+
+	go func() {
+		log.Printf("🚀 Campaign Service (Ad/MTA) is running on port %s", port)
+		// شروع سرویس‌دهی
+		if err := a.GRPCServer.Serve(lis); err != nil {
+			errCh <- fmt.Errorf("grpc server: %w", err)
+		}
+	}()
+	return <-errCh
+}
+
+// consumeDeliveryEvents صف رویدادهای تحویل MTA را به exchange اصلی متصل و مصرف می‌کند
+func (a *App) consumeDeliveryEvents(ctx context.Context) (<-chan port.Delivery, error) {
+	queue := a.Cfg.Sending.EventsQueue
+	if queue == "" {
+		queue = defaultEventsQueue
+	}
+	binding := a.Cfg.Sending.EventsBindingKey
+	if binding == "" {
+		binding = defaultEventsBinding
+	}
+	if err := a.Broker.DeclareQueue(ctx, queue, a.Cfg.Broker.Exchange, binding); err != nil {
+		return nil, fmt.Errorf("declare queue %s: %w", queue, err)
+	}
+	return a.Broker.Consume(ctx, queue, 0)
+}
 
-	// شروع سرویس‌دهی
-	return a.GRPCServer.Serve(lis)
+// goRun کار پس‌زمینه تا لغو ctx اجرا می‌شود و Shutdown منتظر پایان آن می‌ماند
+func (a *App) goRun(name string, run func() error) {
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		if err := run(); err != nil && !errors.Is(err, context.Canceled) {
+			log.Printf("❌ %s stopped: %v", name, err)
+		}
+	}()
 }
 
 // Shutdown منابع را به صورت امن آزاد می‌کند
 func (a *App) Shutdown() {
-	log.Println("🛑 Stopping gRPC Server...")
-	a.GRPCServer.GracefulStop()
+	a.cancel()
+	if a.GRPCServer != nil {
+		log.Println("🛑 Stopping gRPC Server...")
+		a.GRPCServer.GracefulStop()
+	}
+
+	log.Println("🛑 Stopping HTTP server and send pipeline...")
+	a.wg.Wait()
+
+	if a.Broker != nil {
+		_ = a.Broker.Close()
+	}
+	for _, conn := range a.conns {
+		_ = conn.Close()
+	}
 
 	log.Println("🔌 Closing Database Connection Pool...")
-	a.DB.Close() // بستن کانکشن‌های pgx
+	if a.sqlDB != nil {
+		_ = a.sqlDB.Close()
+	}
+	if a.DB != nil {
+		a.DB.Close() // بستن کانکشن‌های pgx
+	}
 }
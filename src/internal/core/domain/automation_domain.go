@@ -0,0 +1,128 @@
+package domain
+
+import "time"
+
+// انواع رویدادهای تاریخ‌محور که توسط Sweep روزانه تولید می‌شوند
+const (
+	TriggerEventBirthday    = "birthday"
+	TriggerEventAnniversary = "anniversary"
+)
+
+// کلیدهای EventConditions که فقط برای Sweep تاریخ‌محور معنا دارند
+const (
+	TriggerConditionListID    = "list_id"
+	TriggerConditionGroupID   = "group_id"
+	TriggerConditionDateField = "date_field" // نام Attribute تاریخ (پیش‌فرض birthday یا تاریخ عضویت برای anniversary)
+)
+
+// AutomationEvent رویداد ورودی (ثبت‌نام، سبد خرید رها شده، ...) برای شروع اتوماسیون‌ها
+type AutomationEvent struct {
+	AccountID    string                 `json:"account_id"`
+	EventType    string                 `json:"event_type"`
+	Email        string                 `json:"email"`
+	SubscriberID string                 `json:"subscriber_id"` // اختیاری (hex)
+	Data         map[string]interface{} `json:"data"`          // با EventConditions مقایسه و به Variables جریان منتقل می‌شود
+	OccurredAt   time.Time              `json:"occurred_at"`
+}
+
+// TriggerResult نتیجه پردازش یک رویداد
+type TriggerResult struct {
+	MatchedAutomations int      `json:"matched_automations"`
+	EnrolledJourneys   []string `json:"enrolled_journeys"`
+	Skipped            []string `json:"skipped"` // دلیل رد شدن (مثلاً one_time_only)
+	// اتوماسیونی که درآمد رویداد فروش به آن نسبت داده شد (خالی اگر رویداد فروش نبود یا ایمیلی در بازه نبود)
+	AttributedAutomation string `json:"attributed_automation,omitempty"`
+}
+
+// AudienceMember عضو لیست/گروه مخاطبین (از سرویس Audience)
+type AudienceMember struct {
+	Email      string            `json:"email"`
+	FirstName  string            `json:"first_name"`
+	LastName   string            `json:"last_name"`
+	Attributes map[string]string `json:"attributes"`
+	Status     string            `json:"status"`
+	JoinedAt   time.Time         `json:"joined_at"`
+}
+
+// رویدادهای فروش که درآمد آن‌ها به اتوماسیون نسبت داده می‌شود (Data["revenue"]، Data["order_id"])
+const (
+	ConversionEventOrderCompleted = "order_completed"
+	ConversionEventPurchase       = "purchase"
+)
+
+// AutomationCounters شمارنده‌های یک اتوماسیون یا یکی از مراحل آن در یک روز (StepID خالی یعنی سطح اتوماسیون)
+// به صورت افزایشی ذخیره و برای گزارش در بازه زمانی جمع زده می‌شوند
+type AutomationCounters struct {
+	AutomationID  string    `json:"automation_id"`
+	StepID        string    `json:"step_id"`
+	Day           time.Time `json:"day"`
+	Entries       int       `json:"entries"`
+	Completions   int       `json:"completions"`
+	DropOffs      int       `json:"drop_offs"`
+	Exited        int       `json:"exited"`
+	EmailsSent    int       `json:"emails_sent"`
+	EmailsOpened  int       `json:"emails_opened"`
+	EmailsClicked int       `json:"emails_clicked"`
+	Conversions   int       `json:"conversions"`
+	Revenue       float64   `json:"revenue"`
+}
+
+// IsZero آیا هیچ شمارنده‌ای تغییر نکرده است
+func (c AutomationCounters) IsZero() bool {
+	return c.Entries == 0 && c.Completions == 0 && c.DropOffs == 0 && c.Exited == 0 && c.EmailsSent == 0 &&
+		c.EmailsOpened == 0 && c.EmailsClicked == 0 && c.Conversions == 0 && c.Revenue == 0
+}
+
+// AutomationConversion درآمد یک سفارش که به آخرین ایمیل اتوماسیون (Last Touch) نسبت داده شده است
+type AutomationConversion struct {
+	AccountID    string    `json:"account_id"`
+	OrderID      string    `json:"order_id"` // برای جلوگیری از ثبت دوباره یک سفارش
+	AutomationID string    `json:"automation_id"`
+	JourneyID    string    `json:"journey_id"`
+	StepID       string    `json:"step_id"`
+	Email        string    `json:"email"`
+	Revenue      float64   `json:"revenue"`
+	OccurredAt   time.Time `json:"occurred_at"`
+}
+
+// FunnelStep آمار یک مرحله در گزارش قیف
+type FunnelStep struct {
+	StepID         string  `json:"step_id"`
+	StepNumber     int     `json:"step_number"`
+	StepType       string  `json:"step_type"`
+	Name           string  `json:"name"`
+	Entries        int     `json:"entries"`
+	Completions    int     `json:"completions"`
+	DropOffs       int     `json:"drop_offs"`
+	CompletionRate float64 `json:"completion_rate"`
+	DropOffRate    float64 `json:"drop_off_rate"`
+	EmailsSent     int     `json:"emails_sent"`
+	EmailsOpened   int     `json:"emails_opened"`
+	EmailsClicked  int     `json:"emails_clicked"`
+}
+
+// AutomationFunnel گزارش قیف اتوماسیون در بازه [From, To)
+type AutomationFunnel struct {
+	AutomationID string       `json:"automation_id"`
+	From         time.Time    `json:"from"`
+	To           time.Time    `json:"to"`
+	Entries      int          `json:"entries"`
+	Completions  int          `json:"completions"`
+	Exited       int          `json:"exited"`
+	Conversions  int          `json:"conversions"`
+	Revenue      float64      `json:"revenue"`
+	Steps        []FunnelStep `json:"steps"`
+}
+
+// JourneyFilter فیلتر فهرست جریان‌ها (فیلدهای خالی نادیده گرفته می‌شوند)
+type JourneyFilter struct {
+	AutomationID string `json:"automation_id"`
+	Email        string `json:"email"`
+	Status       string `json:"status"`
+}
+
+// JourneyAction اطلاعات اقدام دستی که در StepHistory ثبت می‌شود
+type JourneyAction struct {
+	Actor  string `json:"actor"` // کاربر پشتیبانی
+	Reason string `json:"reason"`
+}
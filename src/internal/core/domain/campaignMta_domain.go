@@ -2,6 +2,9 @@ package domain
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -15,6 +18,24 @@ const (
 	StatusFailed     = "failed"
 )
 
+// حالت‌های CampaignOptions.DeliveryOptimization (مقدار خالی یعنی ارسال همه گیرندگان در زمان شروع)
+const (
+	// DeliveryOptimalTime زمان ارسال هر گیرنده در ۲۴ ساعت بعد از شروع بر اساس سابقه باز کردن ایمیل‌های او
+	DeliveryOptimalTime = "optimal_time"
+	// DeliveryLocalTime ارسال در ساعت ScheduledFor (به وقت Options.Timezone) به وقت محلی هر گیرنده (Attribute timezone مخاطب)
+	DeliveryLocalTime = "local_time"
+)
+
+// localTimeMaxOffset شرقی‌ترین منطقه زمانی (UTC+14) هر ساعت دیواری را این مدت زودتر از UTC می‌بیند
+const localTimeMaxOffset = 14 * time.Hour
+
+// سیاست CampaignOptions.LocalTimePolicy برای گیرندگانی که ساعت ارسال در منطقه زمانی‌شان گذشته است
+const (
+	LocalTimeNextDay   = "next_day" // پیش‌فرض: همان ساعت در روز بعد
+	LocalTimeImmediate = "immediate"
+	LocalTimeSkip      = "skip"
+)
+
 // Campaign: مدل اصلی دقیقاً منطبق با message Campaign در پروتو
 type Campaign struct {
 	ID        string `json:"id" bson:"_id"`
@@ -75,6 +96,8 @@ type CampaignRecipient struct {
 
 type CampaignOptions struct {
 	DeliveryOptimization string `json:"delivery_optimization" bson:"delivery_optimization"`
+	LocalTimePolicy      string `json:"local_time_policy" bson:"local_time_policy"` // فقط در حالت local_time
+	Timezone             string `json:"timezone" bson:"timezone"`                   // منطقه زمانی ساعت ScheduledFor در local_time؛ خالی یعنی UTC
 	TrackOpens           bool   `json:"track_opens" bson:"track_opens"`
 	TrackClicks          bool   `json:"track_clicks" bson:"track_clicks"`
 	UseGoogleAnalytics   bool   `json:"use_google_analytics" bson:"use_google_analytics"`
@@ -82,6 +105,9 @@ type CampaignOptions struct {
 	TriggerFrequency     int32  `json:"trigger_frequency" bson:"trigger_frequency"`
 	TriggerCount         int32  `json:"trigger_count" bson:"trigger_count"`
 	UsesSurvey           bool   `json:"uses_survey" bson:"uses_survey"`
+
+	// UTMParameters جایگزین مقادیر پیش‌فرض UTM برای این کمپین (کلیدهای UTMSource و ... و UTMExcludeDomains)
+	UTMParameters map[string]string `json:"utm_parameters,omitempty" bson:"utm_parameters"`
 }
 
 type FilterCondition struct {
@@ -120,6 +146,14 @@ type CampaignStats struct {
 	DeliveryRate float64 `json:"delivery_rate" bson:"delivery_rate"`
 }
 
+// CampaignPreflight نتیجه بررسی پیش از ارسال (Pre-flight) کمپین
+type CampaignPreflight struct {
+	Ready    bool                           `json:"ready"` // true یعنی هیچ خطای مسدودکننده‌ای وجود ندارد
+	Errors   []string                       `json:"errors"`
+	Warnings []string                       `json:"warnings"`
+	Lint     map[string]*TemplateLintResult `json:"lint"` // نتیجه Lint به ازای هر قالب (EmailIDs)
+}
+
 // ---------------------------------------------
 // منطق‌های دامین (Domain Logic)
 // ---------------------------------------------
@@ -138,3 +172,97 @@ func (c *Campaign) ValidateTransition(newStatus string) error {
 
 	return nil
 }
+
+// ValidateDelivery بررسی حالت ارسال و سیاست وقت محلی
+func (o CampaignOptions) ValidateDelivery() error {
+	switch strings.ToLower(o.DeliveryOptimization) {
+	case "", "none", DeliveryOptimalTime, DeliveryLocalTime:
+	default:
+		return fmt.Errorf("unknown delivery optimization %q", o.DeliveryOptimization)
+	}
+	switch strings.ToLower(o.LocalTimePolicy) {
+	case "", LocalTimeNextDay, LocalTimeImmediate, LocalTimeSkip:
+	default:
+		return fmt.Errorf("unknown local time policy %q", o.LocalTimePolicy)
+	}
+	if o.Timezone != "" {
+		if _, err := time.LoadLocation(o.Timezone); err != nil {
+			return fmt.Errorf("invalid timezone %q", o.Timezone)
+		}
+	}
+	return nil
+}
+
+// Location منطقه زمانی کمپین؛ خالی یا نامعتبر یعنی UTC
+func (o CampaignOptions) Location() *time.Location {
+	if o.Timezone != "" {
+		if loc, err := time.LoadLocation(o.Timezone); err == nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
+
+// DispatchAt زمانی که dispatcher کمپین Scheduled را برمی‌دارد
+//
+// در حالت local_time ساعت ScheduledFor اول در شرقی‌ترین منطقه زمانی (UTC+14) فرا می‌رسد،
+// پس کمپین از همان لحظه برداشته می‌شود و آیتم‌های هر منطقه زمانی تا ساعت محلی خود در صف می‌مانند.
+func (c *Campaign) DispatchAt() *time.Time {
+	if c.ScheduledFor == nil {
+		return nil
+	}
+	at := *c.ScheduledFor
+	if strings.EqualFold(c.Options.DeliveryOptimization, DeliveryLocalTime) {
+		w := at.In(c.Options.Location())
+		at = time.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), 0, time.UTC).Add(-localTimeMaxOffset)
+	}
+	return &at
+}
+
+// Apply رویدادهای یک نوع را به آمار اضافه و نرخ‌ها را دوباره محاسبه می‌کند
+//
+// total تعداد رویدادها و unique تعداد گیرندگانی است که برای اولین بار این رویداد را در کمپین داشته‌اند؛
+// ارسال، Bounce، Spam و لغو عضویت هر گیرنده فقط یک بار شمرده می‌شوند تا تحویل دوباره رویداد آمار را بالا نبرد.
+func (s *CampaignStats) Apply(eventType string, total, unique int64) {
+	switch eventType {
+	case EventSent:
+		s.Sent += unique
+	case EventOpened:
+		s.OpensCount += total
+		s.UniqueOpensCount += unique
+	case EventClicked:
+		s.ClicksCount += total
+		s.UniqueClicksCount += unique
+	case EventUnsubscribed:
+		s.UnsubscribesCount += unique
+	case EventComplained:
+		s.SpamCount += unique
+	case EventBounced:
+		s.HardBouncesCount += unique
+	case EventSoftBounced:
+		s.SoftBouncesCount += unique
+	default:
+		return
+	}
+	s.refreshRates()
+}
+
+// refreshRates همه نرخ‌ها نسبت به تعداد ارسال شده
+func (s *CampaignStats) refreshRates() {
+	rate := func(n int64) StatsRate {
+		if s.Sent <= 0 {
+			return StatsRate{Text: "0%"}
+		}
+		v := float64(n) / float64(s.Sent)
+		return StatsRate{Value: v, Text: strconv.FormatFloat(v*100, 'f', 2, 64) + "%"}
+	}
+	s.OpenRate = rate(s.UniqueOpensCount)
+	s.ClickRate = rate(s.UniqueClicksCount)
+	s.UnsubscribeRate = rate(s.UnsubscribesCount)
+	s.SpamRate = rate(s.SpamCount)
+	s.HardBounceRate = rate(s.HardBouncesCount)
+	s.SoftBounceRate = rate(s.SoftBouncesCount)
+	if s.Sent > 0 {
+		s.DeliveryRate = float64(max(s.Sent-s.HardBouncesCount-s.SoftBouncesCount, 0)) / float64(s.Sent)
+	}
+}
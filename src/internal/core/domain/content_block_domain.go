@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"regexp"
+	"time"
+)
+
+// الگوی ارجاع به بلاک در قالب‌ها: {{block "footer"}}
+var BlockTagRe = regexp.MustCompile(`\{\{\s*block\s+["']([A-Za-z0-9_.-]+)["']\s*\}\}`)
+
+// ContentBlock بلاک محتوای قابل استفاده مجدد (هدر، فوتر، متن حقوقی و ...)
+type ContentBlock struct {
+	ID               string    `json:"id"`
+	AccountID        string    `json:"account_id"`
+	Name             string    `json:"name"`
+	Tag              string    `json:"tag"` // نامی که در {{block "tag"}} استفاده می‌شود (یکتا در هر اکانت)
+	CurrentVersionID string    `json:"current_version_id"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// ContentBlockVersion هر نسخه از بلاک (مانند TemplateVersion)
+type ContentBlockVersion struct {
+	ID           string    `json:"id"`
+	BlockID      string    `json:"block_id"`
+	VersionLabel string    `json:"version_label"`
+	HTMLContent  string    `json:"html_content"`
+	PlainText    string    `json:"plain_text"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// BlockUsage یک نسخه فعلی قالب که از بلاک استفاده می‌کند (خروجی Where Used)
+type BlockUsage struct {
+	TemplateID   string              `json:"template_id"`
+	TemplateName string              `json:"template_name"`
+	VersionID    string              `json:"version_id"`
+	Language     string              `json:"language"`
+	LintResult   *TemplateLintResult `json:"lint_result"` // بعد از رندر مجدد پر می‌شود
+}
+
+// TemplateSnapshot نسخه منجمد قالب برای یک کمپین (بلاک‌ها باز شده‌اند)
+type TemplateSnapshot struct {
+	ID          string    `json:"id"`
+	TemplateID  string    `json:"template_id"`
+	VersionID   string    `json:"version_id"`
+	CampaignID  string    `json:"campaign_id"`
+	Language    string    `json:"language"`
+	Subject     string    `json:"subject"`
+	HTMLContent string    `json:"html_content"`
+	PlainText   string    `json:"plain_text"`
+	IsDefault   bool      `json:"is_default"` // نسخه زبان پیش‌فرض قالب؛ وقتی زبان مخاطب پیدا نشود استفاده می‌شود
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ExtractBlockTags نام بلاک‌های ارجاع داده شده در محتوا را (بدون تکرار) برمی‌گرداند
+func ExtractBlockTags(contents ...string) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, c := range contents {
+		for _, m := range BlockTagRe.FindAllStringSubmatch(c, -1) {
+			if !seen[m[1]] {
+				seen[m[1]] = true
+				tags = append(tags, m[1])
+			}
+		}
+	}
+	return tags
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// DeadLetterFilter فیلتر فهرست و حذف آیتم‌های صف مرده (فیلدهای خالی نادیده گرفته می‌شوند)
+type DeadLetterFilter struct {
+	CampaignID string `json:"campaign_id"`
+	ErrorClass string `json:"error_class"`
+	Status     string `json:"status"` // dead یا replayed
+	Email      string `json:"email"`
+}
+
+// DeadLetterEdit تغییرات اختیاری آیتم هنگام Replay
+type DeadLetterEdit struct {
+	// RecipientEmail فقط در Replay تکی قابل تغییر است
+	RecipientEmail string `json:"recipient_email"`
+	// RecipientData با داده قبلی ادغام می‌شود؛ مقدار nil کلید را حذف می‌کند
+	RecipientData map[string]interface{} `json:"recipient_data"`
+	Priority      int                    `json:"priority"` // صفر یعنی همان اولویت قبلی
+}
+
+// DeadLetterSummary تعداد آیتم‌های منتظر بررسی به تفکیک کلاس خطا
+type DeadLetterSummary struct {
+	ErrorClass string    `json:"error_class"`
+	Count      int       `json:"count"`
+	LastDeadAt time.Time `json:"last_dead_at"`
+}
+
+// DeadLetterReplayResult نتیجه Replay گروهی؛ برای هر کمپین یک دسته جدید در صف ساخته می‌شود
+type DeadLetterReplayResult struct {
+	Replayed int      `json:"replayed"`
+	BatchIDs []string `json:"batch_ids"`
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+)
+
+// انواع رویدادهای ارسال و تعامل در سرویس گزارش‌ها (EventType)
+const (
+	EventSent         = "sent"
+	EventDelivered    = "delivered"
+	EventOpened       = "opened"
+	EventClicked      = "clicked"
+	EventBounced      = "bounced" // Hard Bounce
+	EventSoftBounced  = "soft_bounced"
+	EventComplained   = "complained"
+	EventUnsubscribed = "unsubscribed"
+)
+
+// EmailEvent یک رویداد ثبت شده برای گیرنده؛ CampaignID همان transaction_id رویداد است
+type EmailEvent struct {
+	ID         string    `json:"id"`
+	AccountID  string    `json:"account_id"`
+	CampaignID string    `json:"campaign_id"`
+	Type       string    `json:"type"`
+	Email      string    `json:"email"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	At         time.Time `json:"at"`
+}
+
+// EventFilter فیلتر خواندن رویدادها (فیلدهای خالی نادیده گرفته می‌شوند)
+type EventFilter struct {
+	CampaignID string
+	Type       string
+	From       time.Time
+	To         time.Time
+}
+
+// CampaignDomainStats عملکرد کمپین (یا کل اکانت وقتی CampaignID خالی است) به تفکیک دامنه گیرنده
+type CampaignDomainStats struct {
+	CampaignID string              `json:"campaign_id"`
+	From       time.Time           `json:"from"`
+	To         time.Time           `json:"to"`
+	Total      models.DomainStat   `json:"total"`
+	Domains    []models.DomainStat `json:"domains"` // مرتب بر اساس Count؛ بقیه دامنه‌ها در آخرین ردیف با نام other
+	Sampled    bool                `json:"sampled"` // شمارنده‌ها از نمونه رویدادها تخمین زده شده‌اند
+}
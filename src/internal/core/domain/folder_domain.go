@@ -0,0 +1,20 @@
+package domain
+
+import (
+	models "github.com/ehsanshah/campaign-services/domain"
+)
+
+// FolderContents محتوای یک پوشه (به صورت مستقیم یا بازگشتی)
+type FolderContents struct {
+	Folder    *models.MessageFolder  `json:"folder"` // nil یعنی ریشه
+	Folders   []models.MessageFolder `json:"folders"`
+	Templates []*Template            `json:"templates"`
+	Messages  []*models.Message      `json:"messages"`
+}
+
+// FolderUpdate تغییرات UpdateFolder؛ فیلد nil یعنی همان مقدار فعلی پوشه حفظ شود
+type FolderUpdate struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	ParentID    *string `json:"parent_id,omitempty"` // hex؛ رشته خالی یعنی انتقال به ریشه
+}
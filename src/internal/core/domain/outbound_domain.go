@@ -0,0 +1,13 @@
+package domain
+
+// OutboundEmail پیام آماده ارسال از طریق MTA
+type OutboundEmail struct {
+	AccountID string
+	From      string // خالی یعنی فرستنده پیش‌فرض اکانت در MTA
+	To        string
+	Subject   string
+	HTML      string
+	Text      string
+	Headers   map[string]string // سرآیندهای اضافه مثل List-Unsubscribe
+	Metadata  map[string]string // برای رهگیری رویدادهای تحویل (campaign_id و ...)
+}
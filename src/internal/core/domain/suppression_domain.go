@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// دلایل Suppression (مقدار Reason در سرویس Audience)
+const (
+	SuppressionUnsubscribe = "unsubscribe"
+	SuppressionBounce      = "bounce"
+	SuppressionComplaint   = "complaint"
+)
+
+// Suppression ایمیلی که نباید به آن ارسال شود
+type Suppression struct {
+	Email     string    `json:"email"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SuppressionSummary تعداد گیرندگان رد شده یک کمپین به تفکیک دلیل
+type SuppressionSummary struct {
+	Reason string `json:"reason"`
+	Count  int    `json:"count"`
+}
@@ -1,15 +1,30 @@
 package domain
 
-import "time"
+import (
+	"strings"
+	"time"
+)
+
+// DefaultTemplateLanguage زبان پیش‌فرض قالب و انتهای زنجیره Fallback
+const DefaultTemplateLanguage = "en"
+
+// GmailClipThreshold حد تقریبی حجم HTML که بیشتر از آن Gmail پیام را کوتاه (Clip) می‌کند
+const GmailClipThreshold = 102 * 1024
 
 // Template موجودیت اصلی قالب
 type Template struct {
 	ID               string    `json:"id"`
 	AccountID        string    `json:"account_id"` // پی‌نوشت ۱۰
 	Name             string    `json:"name"`
-	CurrentVersionID string    `json:"current_version_id"`
+	CurrentVersionID string    `json:"current_version_id"` // نسخه فعلی زبان پیش‌فرض
+	DefaultLanguage  string    `json:"default_language"`
+	FolderID         string    `json:"folder_id"` // خالی یعنی ریشه (شناسه MessageFolder به صورت hex)
+	Reviewers        []string  `json:"reviewers"` // بازبین‌های مجاز؛ خالی یعنی نسخه‌ها بدون بازبینی تایید می‌شوند
 	CreatedAt        time.Time `json:"created_at"`
 	UpdatedAt        time.Time `json:"updated_at"`
+
+	// نسخه فعلی به ازای هر زبان (language -> version_id)
+	CurrentVersions map[string]string `json:"current_versions"`
 }
 
 // TemplateVersion جزئیات هر نسخه از قالب
@@ -22,6 +37,179 @@ type TemplateVersion struct {
 	PlainText    string    `json:"plain_text"`
 	Language     string    `json:"language"`
 	Tags         []string  `json:"tags"`
-	Metadata     string    `json:"metadata"` // JSON string
+	Metadata     string    `json:"metadata"`   // JSON string
+	Warnings     []string  `json:"warnings"`   // هشدارهای پایپ‌لاین پردازش (مثلاً حجم زیاد)
+	BlockTags    []string  `json:"block_tags"` // بلاک‌های ارجاع داده شده ({{block "footer"}}) برای Where Used
+	Status       string    `json:"status"`     // pending_review, approved, rejected
+	CreatedBy    string    `json:"created_by"`
 	CreatedAt    time.Time `json:"created_at"`
+
+	LintResult *TemplateLintResult `json:"lint_result"` // آخرین نتیجه LintTemplate (JSONB)
+}
+
+// وضعیت‌های بازبینی نسخه قالب
+const (
+	VersionStatusPendingReview = "pending_review"
+	VersionStatusApproved      = "approved"
+	VersionStatusRejected      = "rejected"
+)
+
+// مدت پیش‌فرض قفل ویرایش قالب؛ قفل منقضی شده توسط کاربر دیگر قابل گرفتن است
+const DefaultTemplateLockTTL = 15 * time.Minute
+
+// RequiresReview قالب‌هایی که بازبین دارند (اکانت‌های تحت نظارت) نسخه‌هایشان باید تایید شوند
+func (t *Template) RequiresReview() bool {
+	return len(t.Reviewers) > 0
+}
+
+// IsReviewer بررسی اینکه کاربر جزو بازبین‌های نام‌برده قالب است
+func (t *Template) IsReviewer(userID string) bool {
+	for _, r := range t.Reviewers {
+		if r == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsApproved نسخه‌های قدیمی (بدون وضعیت) تایید شده محسوب می‌شوند
+func (v *TemplateVersion) IsApproved() bool {
+	return v.Status == "" || v.Status == VersionStatusApproved
+}
+
+// TemplateReview تصمیم یک بازبین روی یک نسخه
+type TemplateReview struct {
+	ID         string    `json:"id"`
+	TemplateID string    `json:"template_id"`
+	VersionID  string    `json:"version_id"`
+	ReviewerID string    `json:"reviewer_id"`
+	Decision   string    `json:"decision"` // approved یا rejected
+	Comment    string    `json:"comment"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// TemplateLock قفل ویرایش قالب برای جلوگیری از بازنویسی همزمان
+type TemplateLock struct {
+	TemplateID string    `json:"template_id"`
+	LockedBy   string    `json:"locked_by"`
+	LockedAt   time.Time `json:"locked_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// RenderedTemplate خروجی رندر قالب برای یک گیرنده
+type RenderedTemplate struct {
+	TemplateID string `json:"template_id"`
+	VersionID  string `json:"version_id"`
+	Language   string `json:"language"` // زبان نسخه انتخاب شده (ممکن است با زبان مخاطب فرق کند)
+	Dir        string `json:"dir"`      // ltr یا rtl
+	Subject    string `json:"subject"`
+	HTML       string `json:"html"`
+	Text       string `json:"text"`
+}
+
+// کلیدهای داده مخاطب که زبان او را مشخص می‌کنند (به ترتیب اولویت)
+var ContactLanguageKeys = []string{"language", "lang", "locale"}
+
+// زبان‌های راست‌به‌چپ
+var rtlLanguages = map[string]bool{
+	"fa": true, "ar": true, "he": true, "ur": true, "ps": true,
+	"ckb": true, "yi": true, "dv": true, "sd": true, "ug": true,
+}
+
+// NormalizeLanguage شکل استاندارد تگ زبان را برمی‌گرداند (fa_ir -> fa-IR)
+func NormalizeLanguage(lang string) string {
+	lang = strings.TrimSpace(strings.ReplaceAll(lang, "_", "-"))
+	if lang == "" {
+		return ""
+	}
+	parts := strings.Split(lang, "-")
+	parts[0] = strings.ToLower(parts[0])
+	for i := 1; i < len(parts); i++ {
+		if len(parts[i]) == 2 {
+			parts[i] = strings.ToUpper(parts[i])
+		} else if len(parts[i]) == 4 {
+			parts[i] = strings.ToUpper(parts[i][:1]) + strings.ToLower(parts[i][1:])
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+// LanguageFallbackChain زنجیره جستجوی نسخه را می‌سازد؛ مثلاً fa-IR -> fa -> en
+func LanguageFallbackChain(lang, defaultLang string) []string {
+	var chain []string
+	seen := make(map[string]bool)
+	add := func(l string) {
+		if l != "" && !seen[l] {
+			seen[l] = true
+			chain = append(chain, l)
+		}
+	}
+
+	parts := strings.Split(NormalizeLanguage(lang), "-")
+	for i := len(parts); i > 0; i-- {
+		add(strings.Join(parts[:i], "-"))
+	}
+	add(NormalizeLanguage(defaultLang))
+	add(DefaultTemplateLanguage)
+	return chain
+}
+
+// IsRTLLanguage بر اساس زیرتگ اصلی زبان تشخیص می‌دهد که متن راست‌به‌چپ است یا نه
+func IsRTLLanguage(lang string) bool {
+	primary := strings.SplitN(NormalizeLanguage(lang), "-", 2)[0]
+	return rtlLanguages[primary]
+}
+
+// TemplateProcessingOptions تنظیمات پایپ‌لاین پردازش نسخه قبل از ذخیره
+type TemplateProcessingOptions struct {
+	InlineCSS         bool `json:"inline_css"`          // انتقال قوانین <style> به attribute های style
+	GeneratePlainText bool `json:"generate_plain_text"` // ساخت نسخه متنی در صورت خالی بودن PlainText
+	Minify            bool `json:"minify"`              // حذف فاصله‌ها و کامنت‌های اضافه
+	SizeWarningBytes  int  `json:"size_warning_bytes"`  // صفر یعنی GmailClipThreshold
+}
+
+// سطح اهمیت یافته‌های Lint
+const (
+	LintSeverityError   = "error"   // مانع زمان‌بندی کمپین می‌شود
+	LintSeverityWarning = "warning" // فقط به هشدارهای کمپین اضافه می‌شود
+)
+
+// قوانین Lint قالب
+const (
+	LintRuleMissingUnsubscribe = "missing_unsubscribe"
+	LintRuleImageAlt           = "image_missing_alt"
+	LintRuleImageTextRatio     = "image_text_ratio"
+	LintRuleSpamPhrase         = "spam_phrase"
+	LintRuleAllCapsSubject     = "all_caps_subject"
+	LintRuleUnbalancedHTML     = "unbalanced_html"
+	LintRuleBrokenLink         = "broken_link"
+)
+
+// TemplateLintFinding یک مورد از خروجی Lint
+type TemplateLintFinding struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	Location string `json:"location"` // subject, body یا آدرس لینک/تصویر
+}
+
+// TemplateLintResult نتیجه کامل Lint یک نسخه
+type TemplateLintResult struct {
+	VersionID    string                `json:"version_id"`
+	Passed       bool                  `json:"passed"` // true یعنی هیچ یافته‌ای با سطح error وجود ندارد
+	ErrorCount   int                   `json:"error_count"`
+	WarningCount int                   `json:"warning_count"`
+	Findings     []TemplateLintFinding `json:"findings"`
+	CheckedAt    time.Time             `json:"checked_at"`
+}
+
+// Add یک یافته را ثبت و شمارنده‌ها را به‌روز می‌کند
+func (r *TemplateLintResult) Add(rule, severity, message, location string) {
+	r.Findings = append(r.Findings, TemplateLintFinding{Rule: rule, Severity: severity, Message: message, Location: location})
+	if severity == LintSeverityError {
+		r.ErrorCount++
+	} else {
+		r.WarningCount++
+	}
+	r.Passed = r.ErrorCount == 0
 }
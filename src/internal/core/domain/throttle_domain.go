@@ -0,0 +1,12 @@
+package domain
+
+import models "github.com/ehsanshah/campaign-services/domain"
+
+// DomainPacing نرخ مؤثر فعلی یک دامنه گیرنده بعد از کاهش خودکار بر اساس DomainStat
+type DomainPacing struct {
+	RecipientDomain string            `json:"recipient_domain"`
+	PerMinute       int               `json:"per_minute"`     // نرخ تنظیم شده در قانون
+	EffectiveRate   float64           `json:"effective_rate"` // ارسال در دقیقه
+	BackoffFactor   float64           `json:"backoff_factor"` // ۱ یعنی بدون کاهش
+	Stat            models.DomainStat `json:"stat"`           // آمار رویدادهای تحویل اخیر این دامنه
+}
@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+// مسیرهای رهگیری روی سرور HTTP؛ توکن بعد از آن‌ها می‌آید
+const (
+	TrackOpenPath  = "/o/"
+	TrackClickPath = "/c/"
+)
+
+// TrackingToken محتوای امضا شده پیکسل باز کردن و لینک‌های بازنویسی شده
+type TrackingToken struct {
+	AccountID  string `json:"a"`
+	CampaignID string `json:"c"`
+	Email      string `json:"e"`
+	URL        string `json:"u,omitempty"` // مقصد لینک؛ فقط در توکن کلیک
+	JourneyID  string `json:"j,omitempty"` // ایمیل مرحله یک Journey اتوماسیون؛ تعامل در شرط‌های Journey هم ثبت می‌شود
+	StepID     string `json:"s,omitempty"` // مرحله ایمیل در Journey
+	IssuedAt   int64  `json:"t"`           // زمان رندر (Unix)؛ برای تشخیص باز کردن خودکار بلافاصله بعد از تحویل
+}
+
+// TrackingRequest درخواست HTTP پیکسل یا لینک
+type TrackingRequest struct {
+	Token     string
+	Method    string
+	IPAddress string
+	UserAgent string
+}
+
+// TrackingHit باز کردن یا کلیک ثبت شده؛ Machine یعنی درخواست خودکار (Apple MPP، اسکنر امنیتی) که در آمار شمرده نمی‌شود
+type TrackingHit struct {
+	AccountID     string    `json:"account_id"`
+	CampaignID    string    `json:"campaign_id"`
+	Email         string    `json:"email"`
+	Type          string    `json:"type"` // EventOpened یا EventClicked
+	URL           string    `json:"url"`
+	IPAddress     string    `json:"ip_address"`
+	UserAgent     string    `json:"user_agent"`
+	Machine       bool      `json:"machine"`
+	MachineReason string    `json:"machine_reason"`
+	At            time.Time `json:"at"`
+}
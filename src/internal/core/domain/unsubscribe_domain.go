@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// منبع درخواست لغو عضویت
+const (
+	UnsubscribeOneClick = "one_click" // POST سرویس‌دهنده ایمیل طبق RFC 8058 (دکمه Unsubscribe در Gmail/Yahoo)
+	UnsubscribeLink     = "link"      // تأیید در صفحه لغو عضویت
+)
+
+// UnsubscribePath مسیر لینک لغو عضویت روی سرور HTTP؛ توکن بعد از آن می‌آید
+const UnsubscribePath = "/unsubscribe/"
+
+// UnsubscribeToken محتوای امضا شده لینک لغو عضویت هر گیرنده
+type UnsubscribeToken struct {
+	AccountID  string `json:"a"`
+	CampaignID string `json:"c"`
+	ListID     string `json:"l,omitempty"` // لیستی که گیرنده از آن وارد کمپین شد؛ خالی برای سگمنت‌ها
+	Email      string `json:"e"`
+}
+
+// Unsubscribe لغو عضویت ثبت شده برای کمپین
+type Unsubscribe struct {
+	AccountID  string    `json:"account_id"`
+	CampaignID string    `json:"campaign_id"`
+	ListID     string    `json:"list_id"`
+	Email      string    `json:"email"`
+	Source     string    `json:"source"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	CreatedAt  time.Time `json:"created_at"`
+}
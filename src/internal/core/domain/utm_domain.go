@@ -0,0 +1,25 @@
+package domain
+
+// کلیدهای CampaignOptions.UTMParameters و AutomationSettings.UTMParameters؛ کلیدها با یا بدون پیشوند utm_ پذیرفته می‌شوند
+const (
+	UTMSource   = "utm_source"
+	UTMMedium   = "utm_medium"
+	UTMCampaign = "utm_campaign"
+	UTMContent  = "utm_content"
+	UTMTerm     = "utm_term"
+	// UTMExcludeDomains دامنه‌هایی (جدا شده با کاما) که لینک‌هایشان برچسب نمی‌گیرند؛ زیردامنه‌ها هم مستثنا هستند
+	UTMExcludeDomains = "exclude_domains"
+)
+
+// پارامترهای نسبت دادن درآمد وقتی EcommerceTracking فعال است؛ فروشگاه آن‌ها را همراه رویداد سفارش برمی‌گرداند
+const (
+	EcommerceCampaignParam  = "cs_cid" // شناسه کمپین یا اتوماسیون
+	EcommerceRecipientParam = "cs_eid" // شناسه ثابت گیرنده (هش ایمیل)
+)
+
+// UTMTags پارامترهای نهایی افزوده شده به لینک‌های یک ایمیل
+type UTMTags struct {
+	Params         map[string]string // utm_* با مقدار غیر خالی
+	ExcludeDomains []string
+	Ecommerce      map[string]string // خالی اگر EcommerceTracking فعال نباشد
+}
@@ -0,0 +1,155 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IAutomationRepository تعریف اتوماسیون‌ها (مراحل و تنظیمات)
+type IAutomationRepository interface {
+	CreateAutomation(ctx context.Context, a *models.EmailAutomation) error
+	// UpdateAutomation مراحل را به صورت کامل (در یک تراکنش) جایگزین می‌کند
+	UpdateAutomation(ctx context.Context, a *models.EmailAutomation) error
+	GetAutomation(ctx context.Context, accountID string, id primitive.ObjectID) (*models.EmailAutomation, error)
+	ListAutomations(ctx context.Context, accountID string, limit, offset int) ([]*models.EmailAutomation, int, error)
+	SetActive(ctx context.Context, accountID string, id primitive.ObjectID, active bool) error
+	DeleteAutomation(ctx context.Context, accountID string, id primitive.ObjectID) error
+	// IsCampaignLinked آیا مرحله‌ای از اتوماسیون‌های اکانت به کمپین متصل است
+	IsCampaignLinked(ctx context.Context, accountID, campaignID string) (bool, error)
+
+	// SaveSplitStats آمار شاخه‌های یک مرحله تقسیم را ذخیره می‌کند
+	SaveSplitStats(ctx context.Context, automationID, stepID primitive.ObjectID, stats []models.StepStats) error
+	// SetSplitWinner شاخه برنده مرحله تقسیم (شناسه صفر یعنی حذف برنده)
+	SetSplitWinner(ctx context.Context, automationID, stepID, winnerStepID primitive.ObjectID) error
+
+	// اتوماسیون‌های فعالی که یکی از TriggerEvents آن‌ها eventType است
+	ListActiveByTrigger(ctx context.Context, accountID, eventType string) ([]*models.EmailAutomation, error)
+	// اتوماسیون‌های فعال همه اکانت‌ها با نوع مشخص (برای Sweep روزانه)
+	ListActiveByType(ctx context.Context, types ...models.AutomationType) ([]*models.EmailAutomation, error)
+}
+
+// IJourneyRepository وضعیت جریان هر مشترک در اتوماسیون
+// چند نمونه از سرویس می‌توانند همزمان اجرا شوند: هر جریان با Lease به یک Worker تخصیص داده می‌شود
+// و اگر Worker از کار بیفتد بعد از انقضای Lease توسط Worker دیگری برداشته می‌شود
+type IJourneyRepository interface {
+	CreateJourney(ctx context.Context, j *models.AutomationJourney) error
+	GetJourney(ctx context.Context, accountID string, id primitive.ObjectID) (*models.AutomationJourney, error)
+	// HasJourney آیا مشترک از زمان since وارد اتوماسیون شده است (since صفر یعنی هر زمان)
+	HasJourney(ctx context.Context, automationID primitive.ObjectID, email string, since time.Time) (bool, error)
+	HasActiveJourney(ctx context.Context, automationID primitive.ObjectID, email string) (bool, error)
+
+	// ClaimDueJourneys جریان‌های فعالی که NextStepAt آن‌ها رسیده را برای workerID قفل می‌کند (FOR UPDATE SKIP LOCKED)
+	ClaimDueJourneys(ctx context.Context, workerID string, now time.Time, limit int, lease time.Duration) ([]*models.AutomationJourney, error)
+	// SaveJourney وضعیت را ذخیره و Lease را آزاد می‌کند؛ اگر Lease دیگر متعلق به workerID نباشد خطا برمی‌گرداند
+	SaveJourney(ctx context.Context, j *models.AutomationJourney, workerID string) error
+
+	// تعامل با ایمیل‌ها (باز شدن/کلیک) جدا از جریان ذخیره می‌شود تا با Worker تداخل نداشته باشد
+	// RecordEngagement فقط برای اولین رویداد هر نوع در هر مرحله true برمی‌گرداند
+	RecordEngagement(ctx context.Context, journeyID, stepID primitive.ObjectID, eventType string, at time.Time) (bool, error)
+	ListEngagement(ctx context.Context, journeyID primitive.ObjectID) ([]models.JourneyStep, error)
+
+	// ListJourneys جدیدترین جریان‌ها اول
+	ListJourneys(ctx context.Context, accountID string, filter domain.JourneyFilter, limit, offset int) ([]*models.AutomationJourney, int, error)
+	// LockJourney Lease یک جریان را برای اقدام دستی می‌گیرد (آزادسازی با SaveJourney)؛ اگر Worker در حال پردازش آن باشد خطا برمی‌گرداند
+	LockJourney(ctx context.Context, accountID string, id primitive.ObjectID, owner string, now time.Time, lease time.Duration) (*models.AutomationJourney, error)
+	// SetJourneysStatus وضعیت همه جریان‌های from اتوماسیون را به to تغییر و entry را به StepHistory آن‌ها اضافه می‌کند؛
+	// جریان‌هایی که در حال پردازش هستند تغییر نمی‌کنند و تعدادشان در busy برمی‌گردد
+	SetJourneysStatus(ctx context.Context, accountID string, automationID primitive.ObjectID, from, to string,
+		entry models.JourneyStep, now time.Time) (updated, busy int, err error)
+
+	// ListJourneysByEmail جریان‌های مشترک در اکانت که از since به بعد به‌روز شده‌اند (برای نسبت دادن درآمد)
+	ListJourneysByEmail(ctx context.Context, accountID, email string, since time.Time) ([]*models.AutomationJourney, error)
+	CountActiveJourneys(ctx context.Context, automationID primitive.ObjectID) (int, error)
+
+	// SplitBranchStats آمار شاخه‌های یک مرحله تقسیم از روی جریان‌ها (کلید map همان SplitGroup است)
+	SplitBranchStats(ctx context.Context, automationID, stepID primitive.ObjectID) (map[int]models.StepStats, error)
+}
+
+// IAutomationStatsRepository شمارنده‌های روزانه اتوماسیون‌ها و مراحل آن‌ها
+type IAutomationStatsRepository interface {
+	// IncrementCounters مقادیر را به شمارنده‌های همان روز اضافه می‌کند
+	IncrementCounters(ctx context.Context, counters []domain.AutomationCounters) error
+	// SumCounters جمع شمارنده‌ها به تفکیک مرحله در بازه [from, to)؛ زمان صفر یعنی بدون محدودیت
+	SumCounters(ctx context.Context, automationID string, from, to time.Time) ([]domain.AutomationCounters, error)
+	// RecordConversion سفارش را ثبت و درآمد را به شمارنده‌ها اضافه می‌کند؛ سفارش تکراری false برمی‌گرداند
+	RecordConversion(ctx context.Context, c *domain.AutomationConversion) (bool, error)
+}
+
+// IMessageRepository خواندن محتوای پیام‌ها برای مراحل ایمیل
+type IMessageRepository interface {
+	GetMessage(ctx context.Context, accountID string, id primitive.ObjectID) (*models.Message, error)
+}
+
+// IAudienceClient پورت خروجی سرویس Audience برای خواندن اعضای لیست/گروه
+type IAudienceClient interface {
+	ListMembers(ctx context.Context, accountID, listID, groupID, pageToken string) ([]domain.AudienceMember, string, error)
+}
+
+// IAutomationTriggerService دریافت رویدادها و Sweep تاریخ‌محور (تولد، سالگرد)
+type IAutomationTriggerService interface {
+	// نگاشت RPC دریافت رویداد
+	IngestEvent(ctx context.Context, event *domain.AutomationEvent) (*domain.TriggerResult, error)
+
+	// RunDateSweep اتوماسیون‌های birthday/anniversary را برای روز day اجرا می‌کند (تکرار آن بی‌خطر است)
+	RunDateSweep(ctx context.Context, day time.Time) (int, error)
+	// RunDailySweep در شروع و سپس هر روز (نیمه‌شب UTC) RunDateSweep را اجرا می‌کند
+	RunDailySweep(ctx context.Context) error
+}
+
+// IAutomationService مدیریت اتوماسیون‌ها (نگاشت RPC های AutomationService)
+type IAutomationService interface {
+	CreateAutomation(ctx context.Context, a *models.EmailAutomation) (*models.EmailAutomation, error)
+	// ویرایش اتوماسیون فعال مجاز نیست؛ ابتدا باید غیرفعال شود
+	UpdateAutomation(ctx context.Context, a *models.EmailAutomation) (*models.EmailAutomation, error)
+	GetAutomation(ctx context.Context, accountID, id string) (*models.EmailAutomation, error)
+	ListAutomations(ctx context.Context, accountID string, limit, offset int) ([]*models.EmailAutomation, int, error)
+	ActivateAutomation(ctx context.Context, accountID, id string) (*models.EmailAutomation, error)
+	DeactivateAutomation(ctx context.Context, accountID, id string) (*models.EmailAutomation, error)
+	DeleteAutomation(ctx context.Context, accountID, id string) error
+
+	// GetAutomationFunnel ورود، ریزش و نرخ تکمیل هر مرحله در بازه [from, to)
+	GetAutomationFunnel(ctx context.Context, accountID, automationID string, from, to time.Time) (*domain.AutomationFunnel, error)
+
+	// RollupSplitStats آمار هر شاخه مرحله تقسیم را از جریان‌ها محاسبه و ذخیره می‌کند
+	RollupSplitStats(ctx context.Context, accountID, automationID, stepID string) ([]models.StepStats, error)
+	// DeclareSplitWinner از این پس همه ورودی‌های مرحله تقسیم به شاخه branch می‌روند (branch منفی یعنی حذف برنده)
+	DeclareSplitWinner(ctx context.Context, accountID, automationID, stepID string, branch int) (*models.EmailAutomation, error)
+}
+
+// IJourneyService اقدامات دستی پشتیبانی روی جریان‌ها (نگاشت RPC های JourneyService)؛ هر اقدام در StepHistory ثبت می‌شود
+type IJourneyService interface {
+	ListJourneys(ctx context.Context, accountID string, filter domain.JourneyFilter, limit, offset int) ([]*models.AutomationJourney, int, error)
+
+	PauseJourney(ctx context.Context, accountID, journeyID string, action domain.JourneyAction) (*models.AutomationJourney, error)
+	// ResumeJourney جریان از همان مرحله ادامه می‌یابد؛ تأخیری که در زمان توقف تمام شده بلافاصله رد می‌شود
+	ResumeJourney(ctx context.Context, accountID, journeyID string, action domain.JourneyAction) (*models.AutomationJourney, error)
+	// ExitJourney خروج اجباری؛ ذکر دلیل الزامی است
+	ExitJourney(ctx context.Context, accountID, journeyID string, action domain.JourneyAction) (*models.AutomationJourney, error)
+	// MoveJourney مشترک را به مرحله stepID منتقل می‌کند (جریان متوقف شده متوقف می‌ماند)
+	MoveJourney(ctx context.Context, accountID, journeyID, stepID string, action domain.JourneyAction) (*models.AutomationJourney, error)
+	// ReenrollSubscriber ورود دوباره از مرحله اول، حتی برای اتوماسیون OneTimeOnly؛ جریان قبلی باید تمام شده باشد
+	ReenrollSubscriber(ctx context.Context, accountID, automationID, email string, action domain.JourneyAction) (*models.AutomationJourney, error)
+
+	// توقف/ادامه همه جریان‌های یک اتوماسیون؛ تعداد جریان‌های تغییر کرده برمی‌گردد
+	PauseAutomationJourneys(ctx context.Context, accountID, automationID string, action domain.JourneyAction) (int, error)
+	ResumeAutomationJourneys(ctx context.Context, accountID, automationID string, action domain.JourneyAction) (int, error)
+}
+
+// IAutomationEngine اجرای مرحله به مرحله اتوماسیون‌ها
+type IAutomationEngine interface {
+	// Enroll ورود مشترک به اتوماسیون؛ اولین مرحله بعد از delay اجرا می‌شود
+	Enroll(ctx context.Context, automation *models.EmailAutomation, subscriberID primitive.ObjectID, email string,
+		variables map[string]interface{}, delay time.Duration) (*models.AutomationJourney, error)
+
+	// ProcessDue یک دسته از جریان‌های سررسید را اجرا می‌کند و تعداد پردازش شده را برمی‌گرداند
+	ProcessDue(ctx context.Context) (int, error)
+	// Run تا لغو ctx به صورت دوره‌ای ProcessDue را اجرا می‌کند
+	Run(ctx context.Context) error
+
+	// RecordEngagement ثبت باز شدن/کلیک ایمیل یک مرحله برای شرط‌های بعدی
+	RecordEngagement(ctx context.Context, accountID, journeyID, stepID, eventType string, at time.Time) error
+}
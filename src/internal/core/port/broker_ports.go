@@ -0,0 +1,56 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrPublishNotConfirmed پیام توسط Broker تأیید نشد (Nack یا بسته شدن کانال قبل از Confirm)؛ ارسال باید تکرار شود
+	ErrPublishNotConfirmed = errors.New("message was not confirmed by broker")
+	// ErrDeliveryAcknowledged Ack/Nack دوباره یک پیام یا پیامی که کانال مصرف‌کننده آن بسته شده است
+	ErrDeliveryAcknowledged = errors.New("delivery already acknowledged or its channel is closed")
+)
+
+// BrokerMessage پیام منتشر شده در Broker
+type BrokerMessage struct {
+	MessageID   string
+	ContentType string
+	Headers     map[string]interface{}
+	Body        []byte
+	Priority    uint8 // فقط در صف‌هایی که x-max-priority دارند معنا دارد
+	Persistent  bool  // ذخیره روی دیسک (delivery mode 2)
+	Timestamp   time.Time
+}
+
+// Delivery پیام دریافت شده توسط مصرف‌کننده؛ دقیقاً یکی از Ack یا Nack باید صدا زده شود
+type Delivery interface {
+	Message() BrokerMessage
+	Exchange() string
+	RoutingKey() string
+	// Redelivered پیام قبلاً تحویل و Ack نشده بود (Nack با requeue یا قطع مصرف‌کننده)
+	Redelivered() bool
+
+	Ack() error
+	// Nack با requeue=true پیام را به صف برمی‌گرداند و در غیر این صورت دور ریخته می‌شود
+	Nack(requeue bool) error
+}
+
+// MessageBroker انتشار و مصرف پیام با معنای RabbitMQ: exchange از نوع topic، کلید مسیریابی با * و #،
+// تأیید انتشار (Publisher Confirms)، Ack/Nack دستی و Prefetch برای هر مصرف‌کننده
+type MessageBroker interface {
+	// DeclareExchange یک exchange پایدار از نوع topic (تکراری بودن خطا نیست)
+	DeclareExchange(ctx context.Context, name string) error
+	// DeclareQueue یک صف پایدار که با bindingKeys به exchange متصل می‌شود؛ exchange خالی یعنی فقط exchange پیش‌فرض
+	// (مسیریابی با نام صف)
+	DeclareQueue(ctx context.Context, name, exchange string, bindingKeys ...string) error
+
+	// Publish تا تأیید Broker صبر می‌کند؛ پیامی که به هیچ صفی نرسد مانند RabbitMQ (بدون mandatory) دور ریخته می‌شود
+	Publish(ctx context.Context, exchange, routingKey string, msg BrokerMessage) error
+	// Consume پیام‌های صف را تا لغو ctx برمی‌گرداند (کانال در پایان بسته می‌شود)؛ حداکثر prefetch پیام Ack نشده
+	// همزمان تحویل داده می‌شود و مقدار صفر یا منفی یعنی پیش‌فرض تنظیمات
+	Consume(ctx context.Context, queue string, prefetch int) (<-chan Delivery, error)
+
+	Close() error
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	models "github.com/ehsanshah/campaign-services/domain"
 	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
 )
 
@@ -11,6 +12,7 @@ import (
 type ICampaignRepository interface {
 	// CRUD پایه
 	Create(ctx context.Context, campaign *domain.Campaign) error
+	// Update همه ستون‌ها به جز stats (شمارنده‌ها فقط با ApplyEvents تغییر می‌کنند)
 	Update(ctx context.Context, campaign *domain.Campaign) error
 	GetByID(ctx context.Context, id string, accountID string) (*domain.Campaign, error)
 	Delete(ctx context.Context, id string, accountID string) error
@@ -21,6 +23,21 @@ type ICampaignRepository interface {
 
 	// متد اختصاصی برای تغییر وضعیت سریع
 	UpdateStatus(ctx context.Context, id string, status string) error
+	// SetUsedInAutomations فقط ستون used_in_automations را تغییر می‌دهد (Update آن را ذخیره نمی‌کند)
+	SetUsedInAutomations(ctx context.Context, id string, accountID string, used bool) error
+
+	// ClaimDue کمپین‌های Scheduled که زمان ارسالشان رسیده را به صورت اتمیک Processing می‌کند (همه اکانت‌ها)
+	ClaimDue(ctx context.Context, now time.Time, limit int) ([]*domain.Campaign, error)
+	// ListByStatus کمپین‌های همه اکانت‌ها با وضعیت status (برای پردازش‌های پس‌زمینه)
+	ListByStatus(ctx context.Context, status string, limit int) ([]*domain.Campaign, error)
+	// MarkSending پایان ساخت آیتم‌های صف کمپین Processing را ثبت می‌کند (فقط همین ستون‌ها، نه Stats)
+	MarkSending(ctx context.Context, id string, accountID string, winnerSendingTime string) error
+	// FinishDispatch کمپین Processing را به status (Sent یا Failed) می‌برد و warning غیر خالی را به Warnings اضافه می‌کند
+	FinishDispatch(ctx context.Context, id string, accountID string, status string, finishedAt time.Time, warning string) error
+
+	// ApplyEvents رویدادهای گیرندگان کمپین را ثبت و Stats را به صورت اتمیک به‌روز می‌کند (CampaignStats.Apply)
+	// رویداد کمپینی که وجود ندارد (یا متعلق به اکانت دیگری است) نادیده گرفته می‌شود
+	ApplyEvents(ctx context.Context, accountID, campaignID string, events []domain.EmailEvent) error
 }
 
 // ICampaignService: لایه بیزنس (UseCase)
@@ -42,4 +59,29 @@ type ICampaignService interface {
 
 	// نگاشت DeleteCampaignRequest
 	DeleteCampaign(ctx context.Context, id string, accountID string) error
+
+	// بررسی پیش از ارسال (گیرنده، محتوا و نتیجه Lint قالب‌ها)
+	PreflightCampaign(ctx context.Context, id string, accountID string) (*domain.CampaignPreflight, error)
+
+	// نگاشت GetCampaignDomainStatsRequest؛ id خالی یعنی همه کمپین‌های اکانت در بازه [from, to)
+	// topN دامنه پرتعداد جدا و بقیه در ردیف other جمع می‌شوند
+	GetCampaignDomainStats(ctx context.Context, id string, accountID string, from, to time.Time, topN int) (*domain.CampaignDomainStats, error)
+}
+
+// ICampaignDispatcher ارسال کمپین‌های زمان‌بندی شده (Orchestrator)
+//
+// گیرندگان لیست‌ها و سگمنت‌های کمپین به آیتم‌های صف ارسال (QueueItem.CampaignRef = شناسه کمپین) تبدیل می‌شوند؛
+// کمپین تا ارسال آخرین آیتم Processing می‌ماند و سپس Sent می‌شود.
+type ICampaignDispatcher interface {
+	// DispatchDue کمپین‌هایی که زمان ارسالشان رسیده را به صف می‌فرستد و کمپین‌های تمام شده را Sent می‌کند
+	DispatchDue(ctx context.Context, now time.Time) (int, error)
+	// Run تا لغو ctx هر every یک بار DispatchDue را اجرا می‌کند
+	Run(ctx context.Context, every time.Duration) error
+}
+
+// ICampaignSender ارسال آیتم‌های صف کمپین‌های MTA (QueueItem.CampaignRef) و ایمیل‌های مراحل Journey؛ Handle به عنوان
+// QueueHandler به صف داده می‌شود
+type ICampaignSender interface {
+	// Handle قالب کمپین را برای گیرنده رندر و با سرآیندهای لغو عضویت ارسال می‌کند
+	Handle(ctx context.Context, item *models.QueueItem) (string, error)
 }
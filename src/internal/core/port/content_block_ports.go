@@ -0,0 +1,37 @@
+package port
+
+import (
+	"context"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+)
+
+type IContentBlockRepository interface {
+	SaveBlock(ctx context.Context, b *domain.ContentBlock) error
+	SaveBlockVersion(ctx context.Context, v *domain.ContentBlockVersion) error
+	GetBlock(ctx context.Context, accountID, blockID string) (*domain.ContentBlock, *domain.ContentBlockVersion, error)
+	GetBlockByTag(ctx context.Context, accountID, tag string) (*domain.ContentBlock, *domain.ContentBlockVersion, error)
+	ListBlocks(ctx context.Context, accountID string) ([]*domain.ContentBlock, error)
+	DeleteBlock(ctx context.Context, accountID, blockID string) error
+}
+
+// IBlockExpander جایگزینی {{block "tag"}} با محتوای نسخه فعلی بلاک
+type IBlockExpander interface {
+	ExpandBlocks(ctx context.Context, accountID, content string, html bool) (string, error)
+}
+
+type IContentBlockService interface {
+	IBlockExpander
+
+	CreateBlock(ctx context.Context, b *domain.ContentBlock, v *domain.ContentBlockVersion) (*domain.ContentBlock, error)
+	// ذخیره نسخه جدید و رندر مجدد قالب‌هایی که از بلاک استفاده می‌کنند
+	UpdateBlock(ctx context.Context, accountID, blockID string, v *domain.ContentBlockVersion) (*domain.ContentBlock, []domain.BlockUsage, error)
+	GetBlock(ctx context.Context, accountID, blockID string) (*domain.ContentBlock, *domain.ContentBlockVersion, error)
+	ListBlocks(ctx context.Context, accountID string) ([]*domain.ContentBlock, error)
+	// بلاک در حال استفاده حذف نمی‌شود
+	DeleteBlock(ctx context.Context, accountID, blockID string) error
+
+	// Where Used: نسخه‌های فعلی قالب‌هایی که بلاک را ارجاع داده‌اند
+	ListBlockUsages(ctx context.Context, accountID, blockID string) ([]domain.BlockUsage, error)
+	RerenderBlockUsages(ctx context.Context, accountID, blockID string) ([]domain.BlockUsage, error)
+}
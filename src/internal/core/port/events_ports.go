@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+)
+
+// IEventsClient خواندن رویدادهای ارسال و تعامل از سرویس گزارش‌ها (reports.v1.EventService)
+type IEventsClient interface {
+	// ListEvents صفحه page (از ۱) رویدادها به ترتیب زمان؛ مقدار دوم تعداد کل رویدادهای فیلتر است
+	ListEvents(ctx context.Context, accountID string, filter domain.EventFilter, page int) ([]domain.EmailEvent, int, error)
+}
@@ -0,0 +1,45 @@
+package port
+
+import (
+	"context"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IFolderRepository لایه دیتابیس پوشه‌ها؛ شناسه صفر (NilObjectID) یعنی ریشه
+type IFolderRepository interface {
+	CreateFolder(ctx context.Context, f *models.MessageFolder) error
+	UpdateFolder(ctx context.Context, f *models.MessageFolder) error
+	GetFolder(ctx context.Context, accountID string, id primitive.ObjectID) (*models.MessageFolder, error)
+	ListFolders(ctx context.Context, accountID string) ([]models.MessageFolder, error)
+
+	// شناسه پوشه و تمام زیرپوشه‌هایش (Recursive CTE)
+	ListSubtreeIDs(ctx context.Context, accountID string, id primitive.ObjectID) ([]primitive.ObjectID, error)
+	CountContents(ctx context.Context, accountID string, id primitive.ObjectID) (folders, templates, messages int, err error)
+
+	// حذف پوشه‌ها همراه با قالب‌ها و پیام‌های داخلشان در یک تراکنش
+	DeleteFolders(ctx context.Context, accountID string, ids []primitive.ObjectID) error
+
+	MoveTemplate(ctx context.Context, accountID, templateID string, folderID primitive.ObjectID) error
+	MoveMessage(ctx context.Context, accountID string, messageID, folderID primitive.ObjectID) error
+
+	ListTemplatesInFolders(ctx context.Context, accountID string, ids []primitive.ObjectID) ([]*domain.Template, error)
+	ListMessagesInFolders(ctx context.Context, accountID string, ids []primitive.ObjectID) ([]*models.Message, error)
+}
+
+// IFolderService لایه بیزنس پوشه‌ها؛ شناسه‌ها به صورت hex (همان فرمت API) دریافت می‌شوند
+type IFolderService interface {
+	CreateFolder(ctx context.Context, f *models.MessageFolder) (*models.MessageFolder, error)
+	// فقط فیلدهای مقداردهی شده در FolderUpdate تغییر می‌کنند
+	UpdateFolder(ctx context.Context, accountID, folderID string, u domain.FolderUpdate) (*models.MessageFolder, error)
+	GetFolderTree(ctx context.Context, accountID string) ([]*models.MessageFolderNode, error)
+	DeleteFolder(ctx context.Context, accountID, folderID string, cascade bool) error
+
+	// folderID خالی یعنی انتقال به ریشه
+	MoveTemplate(ctx context.Context, accountID, templateID, folderID string) error
+	MoveMessage(ctx context.Context, accountID, messageID, folderID string) error
+
+	ListFolderContents(ctx context.Context, accountID, folderID string, recursive bool) (*domain.FolderContents, error)
+}
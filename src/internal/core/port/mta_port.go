@@ -1,8 +1,14 @@
 package port
 
-import "context"
+import (
+	"context"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+)
 
 // IMtaService اینترفیس ارتباطی با میکروسرویس ارسال ایمیل
 type IMtaService interface {
 	SendImmediate(ctx context.Context, to string, subject string, html string) error
+	// Send ارسال با سرآیندها و متادیتا؛ Message-ID پیام در MTA برگردانده می‌شود
+	Send(ctx context.Context, email *domain.OutboundEmail) (string, error)
 }
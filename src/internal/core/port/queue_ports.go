@@ -0,0 +1,87 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrPermanentFailure خطای Handler که با آن (errors.Is) آیتم بدون تلاش مجدد Failed می‌شود
+var ErrPermanentFailure = errors.New("permanent failure")
+
+// IQueueRepository صف پایدار ارسال؛ چند Worker می‌توانند همزمان آیتم‌ها را با Lease بردارند
+type IQueueRepository interface {
+	// CreateBatch دسته و آیتم‌های آن را در یک تراکنش ذخیره می‌کند
+	CreateBatch(ctx context.Context, batch *models.QueueBatch, items []*models.QueueItem) error
+	// AppendItems آیتم‌های جدید به دسته موجود اضافه و ItemCount را افزایش می‌دهد
+	AppendItems(ctx context.Context, batchID primitive.ObjectID, items []*models.QueueItem) error
+	GetBatch(ctx context.Context, accountID string, id primitive.ObjectID) (*models.QueueBatch, error)
+
+	// LeaseItems آیتم‌های آماده را به ترتیب اولویت (FOR UPDATE SKIP LOCKED) برای workerID قفل می‌کند؛
+	// آیتمی که Lease آن (visibility) منقضی شود دوباره قابل برداشت است
+	LeaseItems(ctx context.Context, workerID string, now time.Time, limit int, visibility time.Duration) ([]*models.QueueItem, error)
+	// CompleteItem آیتم را Completed و شمارنده‌های دسته را در همان تراکنش به‌روز می‌کند
+	CompleteItem(ctx context.Context, id primitive.ObjectID, workerID, externalID string, now time.Time) error
+	// RetryItem آیتم را برای retryAt دوباره Pending می‌کند (RetryCount یکی اضافه می‌شود)
+	RetryItem(ctx context.Context, id primitive.ObjectID, workerID, lastError string, retryAt time.Time) error
+	// DeferItem آیتم را بدون افزایش RetryCount برای until دوباره Pending می‌کند (محدودیت نرخ دامنه)
+	DeferItem(ctx context.Context, id primitive.ObjectID, workerID string, until time.Time) error
+	// SkipItem گیرنده Suppress شده: آیتم Cancelled، در دسته پردازش شده و دلیل برای کمپین ثبت می‌شود
+	SkipItem(ctx context.Context, id primitive.ObjectID, workerID, reason string, now time.Time) error
+	// FailItem آیتم را Failed، شمارنده‌های دسته را به‌روز و آیتم را با errorClass در صف مرده ثبت می‌کند (یک تراکنش)
+	FailItem(ctx context.Context, id primitive.ObjectID, workerID, lastError, errorClass string, now time.Time) error
+
+	// PendingItems تعداد آیتم‌های Pending یا Processing یک کمپین MTA (QueueItem.CampaignRef)
+	PendingItems(ctx context.Context, accountID, campaignRef string) (int, error)
+}
+
+// QueueHandler ارسال یک آیتم؛ شناسه خارجی (مثلاً Message-ID در MTA) برمی‌گرداند
+type QueueHandler func(ctx context.Context, item *models.QueueItem) (externalID string, err error)
+
+// ISendQueue صف ارسال پیام‌ها
+type ISendQueue interface {
+	// Enqueue دسته جدید؛ مقادیر خالی Priority، MaxRetries و ScheduledAt با پیش‌فرض‌ها پر می‌شوند
+	Enqueue(ctx context.Context, accountID string, campaignID primitive.ObjectID, items []*models.QueueItem) (*models.QueueBatch, error)
+	GetBatch(ctx context.Context, accountID, batchID string) (*models.QueueBatch, error)
+	// PendingItems تعداد آیتم‌های ارسال نشده کمپین MTA؛ صفر یعنی ارسال کمپین تمام شده است
+	PendingItems(ctx context.Context, accountID, campaignRef string) (int, error)
+
+	// Run تا لغو ctx آیتم‌ها را با workers گوروتین به handler می‌دهد؛ خطاها با Backoff نمایی تا MaxRetries تکرار می‌شوند
+	Run(ctx context.Context, handler QueueHandler, workers int) error
+}
+
+// IDeadLetterRepository آیتم‌های صف که بعد از همه تلاش‌ها شکست خورده‌اند (توسط FailItem ثبت می‌شوند)
+type IDeadLetterRepository interface {
+	// ListDeadLetters جدیدترین‌ها اول
+	ListDeadLetters(ctx context.Context, accountID string, filter domain.DeadLetterFilter, limit, offset int) ([]*models.DeadLetter, int, error)
+	GetDeadLetter(ctx context.Context, accountID string, id primitive.ObjectID) (*models.DeadLetter, error)
+	// SummarizeDeadLetters تعداد آیتم‌های dead به تفکیک کلاس خطا (campaignID خالی یعنی همه کمپین‌ها)
+	SummarizeDeadLetters(ctx context.Context, accountID, campaignID string) ([]domain.DeadLetterSummary, error)
+
+	// ReplayDeadLetters در یک تراکنش دسته و آیتم‌های جدید صف را می‌سازد و letterIDs[i] را با items[i] Replayed می‌کند؛
+	// اگر یکی از آن‌ها دیگر dead نباشد (Replay همزمان) هیچ تغییری ذخیره نمی‌شود
+	ReplayDeadLetters(ctx context.Context, batch *models.QueueBatch, items []*models.QueueItem, letterIDs []primitive.ObjectID, now time.Time) error
+	// PurgeDeadLetters حذف آیتم‌های منطبق که قبل از before مرده‌اند (before صفر یعنی بدون محدودیت زمانی)
+	PurgeDeadLetters(ctx context.Context, accountID string, filter domain.DeadLetterFilter, before time.Time) (int, error)
+}
+
+// IDeadLetterService بررسی و بازیابی آیتم‌های صف مرده (مثلاً بعد از قطعی ارائه‌دهنده)
+type IDeadLetterService interface {
+	ListDeadLetters(ctx context.Context, accountID string, filter domain.DeadLetterFilter, limit, offset int) ([]*models.DeadLetter, int, error)
+	// GetDeadLetter آیتم کامل همراه تاریخچه خطاها
+	GetDeadLetter(ctx context.Context, accountID, id string) (*models.DeadLetter, error)
+	SummarizeDeadLetters(ctx context.Context, accountID, campaignID string) ([]domain.DeadLetterSummary, error)
+
+	// ReplayDeadLetter آیتم را (با تغییرات اختیاری) در دسته جدیدی به صف ارسال برمی‌گرداند
+	ReplayDeadLetter(ctx context.Context, accountID, id string, edit domain.DeadLetterEdit) (*models.QueueBatch, error)
+	// ReplayDeadLetters Replay گروهی: ids مشخص یا در صورت خالی بودن همه آیتم‌های dead منطبق با filter (حداکثر max)
+	ReplayDeadLetters(ctx context.Context, accountID string, ids []string, filter domain.DeadLetterFilter,
+		edit domain.DeadLetterEdit, max int) (*domain.DeadLetterReplayResult, error)
+
+	// PurgeDeadLetters حذف دائمی؛ حداقل یکی از فیلترها یا before باید مشخص باشد
+	PurgeDeadLetters(ctx context.Context, accountID string, filter domain.DeadLetterFilter, before time.Time) (int, error)
+}
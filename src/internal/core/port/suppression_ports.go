@@ -0,0 +1,55 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+)
+
+// ISuppressionClient پورت خروجی سرویس Suppression در Audience (لیست سیاه هر اکانت)
+type ISuppressionClient interface {
+	// ListSuppressions یک صفحه؛ pageToken خالی در پاسخ یعنی صفحه آخر
+	ListSuppressions(ctx context.Context, accountID string, pageToken []byte) ([]domain.Suppression, []byte, error)
+	// GetSuppression مقدار nil یعنی ایمیل Suppress نشده است
+	GetSuppression(ctx context.Context, accountID, email string) (*domain.Suppression, error)
+	// BulkAddSuppressions افزودن به فهرست اکانت؛ تعداد پردازش شده برگردانده می‌شود
+	BulkAddSuppressions(ctx context.Context, accountID string, items []domain.Suppression) (int, error)
+}
+
+// ISuppressionRepository نسخه محلی Bounce ها و Complaint های سراسری (همه اکانت‌ها) و گیرندگان رد شده هر کمپین
+type ISuppressionRepository interface {
+	// ListGlobalSuppressions مواردی که بعد از since اضافه شده‌اند (since صفر یعنی همه)
+	ListGlobalSuppressions(ctx context.Context, since time.Time) ([]domain.Suppression, error)
+	// AddGlobalSuppressions درج یا نادیده گرفتن ایمیل‌های تکراری
+	AddGlobalSuppressions(ctx context.Context, items []domain.Suppression) error
+
+	// RecordSoftBounce شمارنده Soft Bounce های پیاپی گیرنده را افزایش و مقدار جدید را برمی‌گرداند؛
+	// اگر اولین Soft Bounce ثبت شده قدیمی‌تر از window باشد شمارش از نو شروع می‌شود؛ eventID تکراری دوباره شمرده نمی‌شود
+	// (خالی یعنی بدون حذف تکرار)
+	RecordSoftBounce(ctx context.Context, eventID, accountID, email string, at time.Time, window time.Duration) (int, error)
+	// ResetSoftBounces بعد از تحویل موفق، Soft Bounce ها دیگر پیاپی نیستند
+	ResetSoftBounces(ctx context.Context, accountID string, emails []string) error
+	// PruneProcessedEvents شناسه رویدادهای ثبت شده قبل از before را پاک می‌کند (حذف تکرار فقط برای تحویل دوباره لازم است)
+	PruneProcessedEvents(ctx context.Context, before time.Time) error
+
+	// SummarizeCampaignSuppressions گیرندگان رد شده کمپین (شناسه کمپین MTA یا ObjectID) به تفکیک دلیل
+	SummarizeCampaignSuppressions(ctx context.Context, accountID, campaignID string) ([]domain.SuppressionSummary, error)
+}
+
+// ISuppressionService بررسی گیرنده پیش از ارسال؛ فهرست هر اکانت یک بار خوانده و در حافظه نگه داشته می‌شود
+type ISuppressionService interface {
+	// Check دلیل Suppression گیرنده یا رشته خالی
+	Check(ctx context.Context, accountID, email string) (string, error)
+	// Suppressed ایمیل را بدون انتظار برای بارگذاری دوباره فهرست به حافظه اضافه می‌کند
+	Suppressed(accountID, email, reason string)
+	SummarizeCampaign(ctx context.Context, accountID, campaignID string) ([]domain.SuppressionSummary, error)
+}
+
+// IDeliveryEventService رویدادهای تحویل و تعامل (Bounce، Complaint، لغو عضویت و ...) را به Suppression و آمار کمپین تبدیل می‌کند
+type IDeliveryEventService interface {
+	// HandleEvents دسته‌ای از رویدادها؛ در صورت خطا کل دسته باید دوباره پردازش شود
+	HandleEvents(ctx context.Context, events []domain.EmailEvent) error
+	// Consume رویدادهای JSON صف را تا لغو ctx دسته‌ای پردازش می‌کند و پیام‌ها را بعد از ثبت Ack می‌کند
+	Consume(ctx context.Context, deliveries <-chan Delivery) error
+}
@@ -10,14 +10,75 @@ type ITemplateRepository interface {
 	SaveTemplate(ctx context.Context, t *domain.Template) error
 	SaveVersion(ctx context.Context, v *domain.TemplateVersion) error
 	GetTemplate(ctx context.Context, accountID, template_id string) (*domain.Template, *domain.TemplateVersion, error)
+	GetVersion(ctx context.Context, accountID, versionID string) (*domain.TemplateVersion, error)
+	SaveLintResult(ctx context.Context, versionID string, result *domain.TemplateLintResult) error
+
+	// نسخه فعلی به ازای هر زبان
+	SetCurrentVersion(ctx context.Context, templateID, language, versionID string) error
+	GetCurrentVersions(ctx context.Context, templateID string) (map[string]string, error)
+
 	ListTemplates(ctx context.Context, accountID string, limit, offset int32) ([]*domain.Template, int32, error)
 	DeleteTemplate(ctx context.Context, accountID, template_id string) error
+
+	// Where Used بلاک‌ها و اسنپ‌شات کمپین
+	ListBlockUsages(ctx context.Context, accountID, tag string) ([]domain.BlockUsage, error)
+	SaveSnapshot(ctx context.Context, snap *domain.TemplateSnapshot) error
+	ListSnapshots(ctx context.Context, campaignID, templateID string) ([]*domain.TemplateSnapshot, error)
+
+	// بازبینی نسخه‌ها
+	SetVersionStatus(ctx context.Context, versionID, status string) error
+	SaveReview(ctx context.Context, review *domain.TemplateReview) error
+	ListReviews(ctx context.Context, accountID, versionID string) ([]domain.TemplateReview, error)
+
+	// قفل ویرایش: AcquireLock فقط وقتی موفق است که قفلی نباشد، منقضی شده باشد یا متعلق به همان کاربر باشد
+	AcquireLock(ctx context.Context, lock *domain.TemplateLock) (*domain.TemplateLock, error)
+	GetLock(ctx context.Context, templateID string) (*domain.TemplateLock, error)
+	ReleaseLock(ctx context.Context, templateID, userID string) error
 }
 
 type ITemplateServices interface {
 	CreateTemplate(ctx context.Context, t *domain.Template, v *domain.TemplateVersion) (*domain.Template, error)
 	UpdateTemplate(ctx context.Context, accountID string, templateID string, v *domain.TemplateVersion) (*domain.Template, error)
 	CopyTemplate(ctx context.Context, accountID, sourceID, newName string) (*domain.Template, error)
+	ListTemplates(ctx context.Context, accountID string, limit, offset int32) ([]*domain.Template, int32, error)
 	ImportTemplateFromUrl(ctx context.Context, accountID, name, url string) (*domain.Template, error)
 	TestTemplate(ctx context.Context, accountID, templateID, versionID, testEmail string) error // ✅ اضافه شد
+	// نگاشت LintTemplate؛ versionID خالی یعنی نسخه فعلی
+	LintTemplate(ctx context.Context, accountID, templateID, versionID string) (*domain.TemplateLintResult, error)
+
+	// نسخه‌های چندزبانه: انتخاب نسخه فعلی یک زبان و انتخاب نسخه مناسب برای مخاطب
+	SetCurrentVersion(ctx context.Context, accountID, templateID, versionID, userID string) (*domain.Template, error)
+	ResolveVariant(ctx context.Context, accountID, templateID, language string) (*domain.TemplateVersion, error)
+	RenderTemplate(ctx context.Context, accountID, templateID string, contact map[string]string) (*domain.RenderedTemplate, error)
+
+	// فریز کردن نسخه فعلی همه زبان‌های قالب (با بلاک‌های باز شده) برای یک کمپین و رندر از همان اسنپ‌شات
+	SnapshotTemplate(ctx context.Context, accountID, templateID, campaignID string) ([]*domain.TemplateSnapshot, error)
+	RenderSnapshot(ctx context.Context, accountID, templateID, campaignID string, contact map[string]string) (*domain.RenderedTemplate, error)
+
+	// گردش کار تایید: فقط نسخه‌های تایید شده می‌توانند نسخه فعلی شوند
+	SetReviewers(ctx context.Context, accountID, templateID string, reviewers []string) (*domain.Template, error)
+	ApproveVersion(ctx context.Context, accountID, templateID, versionID, reviewerID, comment string) (*domain.TemplateVersion, error)
+	RejectVersion(ctx context.Context, accountID, templateID, versionID, reviewerID, comment string) (*domain.TemplateVersion, error)
+	ListReviews(ctx context.Context, accountID, templateID, versionID string) ([]domain.TemplateReview, error)
+
+	DeleteTemplate(ctx context.Context, accountID, templateID, userID string) error
+
+	// قفل ویرایش قالب (UpdateTemplate، SetCurrentVersion، ApproveVersion و DeleteTemplate با قفل کاربر دیگر رد می‌شوند)
+	LockTemplate(ctx context.Context, accountID, templateID, userID string) (*domain.TemplateLock, error)
+	UnlockTemplate(ctx context.Context, accountID, templateID, userID string) error
+}
+
+// ITemplateProcessor پایپ‌لاین پردازش HTML نسخه (Inline CSS، نسخه متنی، Minify)
+type ITemplateProcessor interface {
+	Process(ctx context.Context, v *domain.TemplateVersion) error
+}
+
+// ITemplateLinter بررسی کیفیت HTML نسخه (لینک لغو اشتراک، alt تصاویر، کلمات اسپم و ...)
+type ITemplateLinter interface {
+	Lint(ctx context.Context, v *domain.TemplateVersion) *domain.TemplateLintResult
+}
+
+// ILinkResolver پورت اختیاری برای بررسی در دسترس بودن لینک‌ها
+type ILinkResolver interface {
+	Resolve(ctx context.Context, url string) error
 }
@@ -0,0 +1,46 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IDomainThrottleRepository قوانین محدودیت نرخ به تفکیک دامنه گیرنده
+type IDomainThrottleRepository interface {
+	// ListRules قوانین اکانت همراه پیش‌فرض‌های سراسری (AccountID خالی)
+	ListRules(ctx context.Context, accountID string) ([]*models.DomainThrottleRule, error)
+	// SaveRule درج یا به‌روزرسانی بر اساس (اکانت، دامنه فرستنده، دامنه گیرنده)
+	SaveRule(ctx context.Context, rule *models.DomainThrottleRule) error
+	DeleteRule(ctx context.Context, accountID string, id primitive.ObjectID) error
+
+	// TakeToken یک توکن از Bucket مشترک key برمی‌دارد (rate توکن در ثانیه)؛ مقدار غیر صفر مدت انتظار تا توکن بعدی است
+	TakeToken(ctx context.Context, key string, now time.Time, rate, burst float64) (time.Duration, error)
+	// RecordDomainEvents شمارنده‌های رویدادهای تحویل را به بازه‌های ثبت شده اضافه می‌کند
+	RecordDomainEvents(ctx context.Context, counts []models.DomainEventCount) error
+	// RecentDomainStats آمار رویدادهای تحویل از since به تفکیک دامنه گیرنده
+	// (BounceRate نسبت Hard Bounce و DeferralRate نسبت Soft Bounce به ارسال‌ها)
+	RecentDomainStats(ctx context.Context, accountID string, since time.Time) ([]models.DomainStat, error)
+	// PruneThrottleState Bucket های بدون استفاده و شمارنده‌های قدیمی را حذف می‌کند
+	PruneThrottleState(ctx context.Context, bucketsBefore, statsBefore time.Time) error
+}
+
+// IDomainThrottle محدودیت نرخ ارسال با Token Bucket برای هر (اکانت، دامنه فرستنده، دامنه گیرنده)
+//
+// Bucket ها در Postgres نگه داشته می‌شوند و نرخ بین همه نمونه‌های سرویس تقسیم می‌شود.
+// اگر BounceRate یا DeferralRate رویدادهای تحویل اخیر یک دامنه از آستانه بگذرد نرخ آن به صورت خودکار کاهش می‌یابد.
+type IDomainThrottle interface {
+	// Reserve یک ارسال به recipientEmail را ثبت می‌کند؛ مقدار غیر صفر یعنی ارسال تا این مدت به تعویق بیفتد
+	Reserve(ctx context.Context, accountID, sendingDomain, recipientEmail string, now time.Time) (time.Duration, error)
+
+	ListRules(ctx context.Context, accountID string) ([]*models.DomainThrottleRule, error)
+	SetRule(ctx context.Context, rule *models.DomainThrottleRule) (*models.DomainThrottleRule, error)
+	DeleteRule(ctx context.Context, accountID, ruleID string) error
+	// RecordEvents رویدادهای ارسال، Bounce و Soft Bounce را در آمار دامنه‌های گیرنده ثبت می‌کند
+	RecordEvents(ctx context.Context, events []domain.EmailEvent) error
+	// GetDomainPacing نرخ مؤثر فعلی دامنه‌هایی که قانون دارند
+	GetDomainPacing(ctx context.Context, accountID, sendingDomain string) ([]domain.DomainPacing, error)
+}
@@ -0,0 +1,22 @@
+package port
+
+import (
+	"context"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+)
+
+// ITrackingRepository همه باز کردن‌ها و کلیک‌ها (شامل درخواست‌های خودکار) با IP و User-Agent
+type ITrackingRepository interface {
+	RecordHit(ctx context.Context, hit *domain.TrackingHit) error
+}
+
+// ITrackingService رهگیری باز کردن و کلیک ایمیل‌ها
+type ITrackingService interface {
+	// Instrument لینک‌های http(s) را به لینک‌های امضا شده رهگیری تبدیل (clicks) و پیکسل ۱×۱ را به انتهای body اضافه (opens) می‌کند
+	Instrument(html string, token domain.TrackingToken, opens, clicks bool) (string, error)
+	// RecordOpen باز کردن غیر خودکار در آمار کمپین شمرده می‌شود
+	RecordOpen(ctx context.Context, req domain.TrackingRequest) error
+	// RecordClick مقصد لینک را برمی‌گرداند؛ اگر ثبت کلیک شکست بخورد هم مقصد برگردانده می‌شود
+	RecordClick(ctx context.Context, req domain.TrackingRequest) (string, error)
+}
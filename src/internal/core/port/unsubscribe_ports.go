@@ -0,0 +1,26 @@
+package port
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+)
+
+// ErrInvalidToken توکن امضا شده (لغو عضویت یا رهگیری) نامعتبر یا دستکاری شده است
+var ErrInvalidToken = errors.New("invalid or tampered token")
+
+// IUnsubscribeRepository لغو عضویت‌های ثبت شده از لینک‌های کمپین
+type IUnsubscribeRepository interface {
+	// RecordUnsubscribe ثبت تکراری (همان گیرنده و کمپین) نادیده گرفته می‌شود
+	RecordUnsubscribe(ctx context.Context, u *domain.Unsubscribe) error
+}
+
+// IUnsubscribeService لغو عضویت یک کلیکی (RFC 8058) برای کمپین‌های MTA
+type IUnsubscribeService interface {
+	// Headers سرآیندهای List-Unsubscribe و List-Unsubscribe-Post گیرنده
+	Headers(token domain.UnsubscribeToken) (map[string]string, error)
+	// Unsubscribe توکن را بررسی، لغو عضویت را برای کمپین و لیست ثبت و گیرنده را Suppress می‌کند
+	// درخواست تکراری خطا نیست
+	Unsubscribe(ctx context.Context, token, source, ipAddress, userAgent string) (*domain.Unsubscribe, error)
+}
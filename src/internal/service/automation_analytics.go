@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// journeySnapshot وضعیت جریان قبل از پردازش؛ شمارنده‌ها از تفاوت آن با وضعیت بعد از پردازش به دست می‌آیند
+type journeySnapshot struct {
+	historyLen   int
+	waitingIndex int // اندیس ورودی منتظر (تأخیر یا ایمیل به تعویق افتاده)؛ -1 اگر نباشد
+	status       string
+}
+
+func snapshotJourney(j *models.AutomationJourney) journeySnapshot {
+	snap := journeySnapshot{historyLen: len(j.StepHistory), waitingIndex: -1, status: j.Status}
+	if i := lastHistoryIndex(j, len(j.StepHistory)); i >= 0 && j.StepHistory[i].Status == models.JOURNEY_STEP_WAITING {
+		snap.waitingIndex = i
+	}
+	return snap
+}
+
+// journeyCounters تغییرات شمارنده‌ها بین before و وضعیت فعلی جریان
+//
+// ورود به مرحله: ورودی جدید در StepHistory (تلاش مجدد ایمیل ناموفق ورود دوباره حساب نمی‌شود)
+// تکمیل مرحله: ورودی با وضعیت completed (شامل پایان تأخیر و ارسال ایمیل به تعویق افتاده)
+// ریزش: خروج جریان در مرحله‌ای غیر از exit
+func journeyCounters(j *models.AutomationJourney, before journeySnapshot, now time.Time) []domain.AutomationCounters {
+	var order []primitive.ObjectID
+	byStep := make(map[primitive.ObjectID]*domain.AutomationCounters)
+	counter := func(stepID primitive.ObjectID) *domain.AutomationCounters {
+		c, ok := byStep[stepID]
+		if !ok {
+			c = &domain.AutomationCounters{AutomationID: j.AutomationID.Hex(), Day: now}
+			if !stepID.IsZero() {
+				c.StepID = stepID.Hex()
+			}
+			byStep[stepID] = c
+			order = append(order, stepID)
+		}
+		return c
+	}
+
+	completed := func(h models.JourneyStep) {
+		if h.Status != models.JOURNEY_STEP_COMPLETED {
+			return
+		}
+		c := counter(h.StepID)
+		c.Completions++
+		if h.StepType == models.STEP_EMAIL {
+			c.EmailsSent++
+		}
+	}
+
+	// ورودی منتظر که در این دور تمام شده است
+	if w := before.waitingIndex; w >= 0 && w < len(j.StepHistory) && j.StepHistory[w].Status != models.JOURNEY_STEP_WAITING {
+		completed(j.StepHistory[w])
+	}
+	for i := before.historyLen; i < len(j.StepHistory); i++ {
+		h := j.StepHistory[i]
+		if h.StepType == models.STEP_MANUAL {
+			continue
+		}
+		if !isRetry(j, i) {
+			counter(h.StepID).Entries++
+		}
+		completed(h)
+	}
+
+	if before.status == models.JOURNEY_ACTIVE && j.Status != models.JOURNEY_ACTIVE {
+		switch j.Status {
+		case models.JOURNEY_COMPLETED:
+			counter(primitive.NilObjectID).Completions++
+		case models.JOURNEY_EXITED:
+			counter(primitive.NilObjectID).Exited++
+			if last := lastHistory(j); last == nil || last.StepType != models.STEP_EXIT {
+				counter(j.CurrentStepID).DropOffs++
+			}
+		}
+	}
+
+	counters := make([]domain.AutomationCounters, 0, len(order))
+	for _, id := range order {
+		counters = append(counters, *byStep[id])
+	}
+	return counters
+}
+
+// isRetry آیا ورودی i تلاش مجدد ایمیلی است که قبلاً ناموفق بوده
+func isRetry(j *models.AutomationJourney, i int) bool {
+	p := lastHistoryIndex(j, i)
+	if p < 0 {
+		return false
+	}
+	prev := j.StepHistory[p]
+	return prev.StepID == j.StepHistory[i].StepID && prev.Status == models.JOURNEY_STEP_FAILED
+}
+
+// recordCounters خطای ثبت آمار نباید اجرای جریان را متوقف کند
+func (e *automationEngine) recordCounters(ctx context.Context, counters ...domain.AutomationCounters) {
+	if e.stats == nil || len(counters) == 0 {
+		return
+	}
+	if err := e.stats.IncrementCounters(ctx, counters); err != nil {
+		log.Printf("⚠️ automation stats: %v", err)
+	}
+}
+
+// ---------------------------------------------------------
+// گزارش‌ها
+// ---------------------------------------------------------
+
+func (s *automationServices) GetAutomationFunnel(ctx context.Context, accountID, automationID string, from, to time.Time) (*domain.AutomationFunnel, error) {
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return nil, errors.New("from must be before to")
+	}
+	a, err := s.GetAutomation(ctx, accountID, automationID)
+	if err != nil {
+		return nil, err
+	}
+	sums, err := s.stats.SumCounters(ctx, a.ID.Hex(), from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	byStep := make(map[string]domain.AutomationCounters, len(sums))
+	for _, c := range sums {
+		byStep[c.StepID] = c
+	}
+
+	total := byStep[""]
+	funnel := &domain.AutomationFunnel{
+		AutomationID: a.ID.Hex(),
+		From:         from,
+		To:           to,
+		Entries:      total.Entries,
+		Completions:  total.Completions,
+		Exited:       total.Exited,
+		Conversions:  total.Conversions,
+		Revenue:      total.Revenue,
+		Steps:        make([]domain.FunnelStep, 0, len(a.Steps)),
+	}
+	for _, st := range a.Steps {
+		c := byStep[st.ID.Hex()]
+		funnel.Steps = append(funnel.Steps, domain.FunnelStep{
+			StepID:         st.ID.Hex(),
+			StepNumber:     st.StepNumber,
+			StepType:       st.StepType,
+			Name:           st.Name,
+			Entries:        c.Entries,
+			Completions:    c.Completions,
+			DropOffs:       c.DropOffs,
+			CompletionRate: ratio(c.Completions, c.Entries),
+			DropOffRate:    ratio(c.DropOffs, c.Entries),
+			EmailsSent:     c.EmailsSent,
+			EmailsOpened:   c.EmailsOpened,
+			EmailsClicked:  c.EmailsClicked,
+		})
+	}
+	return funnel, nil
+}
+
+// applyTotals آمار کل اتوماسیون و مراحل را از شمارنده‌ها پر می‌کند
+func (s *automationServices) applyTotals(ctx context.Context, a *models.EmailAutomation) error {
+	if s.stats == nil {
+		return nil
+	}
+	sums, err := s.stats.SumCounters(ctx, a.ID.Hex(), time.Time{}, time.Time{})
+	if err != nil {
+		return err
+	}
+	active, err := s.journeys.CountActiveJourneys(ctx, a.ID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	byStep := make(map[string]domain.AutomationCounters, len(sums))
+	for _, c := range sums {
+		byStep[c.StepID] = c
+	}
+	total := byStep[""]
+	a.Statistics = models.AutomationStats{
+		EntriesCount:     total.Entries,
+		CompletionsCount: total.Completions,
+		ActiveNow:        active,
+		ExitedCount:      total.Exited,
+		RevenueGenerated: total.Revenue,
+		LastUpdatedAt:    now,
+	}
+	for i := range a.Steps {
+		st := &a.Steps[i]
+		c := byStep[st.ID.Hex()]
+		st.Statistics = models.StepStats{
+			EntriesCount:     c.Entries,
+			CompletionsCount: c.Completions,
+			DropOffs:         c.DropOffs,
+			EmailsSent:       c.EmailsSent,
+			EmailsOpened:     c.EmailsOpened,
+			EmailsClicked:    c.EmailsClicked,
+			ConversionRate:   ratio(c.Completions, c.Entries),
+			LastUpdatedAt:    now,
+		}
+		// برای مراحل ایمیل تبدیل یعنی کلیک روی ایمیل ارسال شده
+		if st.StepType == models.STEP_EMAIL {
+			st.Statistics.ConversionRate = ratio(c.EmailsClicked, c.EmailsSent)
+		}
+	}
+	return nil
+}
+
+func ratio(part, whole int) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return float64(part) / float64(whole)
+}
@@ -0,0 +1,199 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+)
+
+// فیلدهای تعامل که از تاریخچه جریان خوانده می‌شوند (بقیه فیلدها از داده مشترک)
+const (
+	fieldEmailOpened  = "email_opened"
+	fieldEmailClicked = "email_clicked"
+)
+
+// evaluateConditions همه شرط‌ها باید برقرار باشند (AND)؛ data مقادیر بررسی شده برای ConditionData است
+func evaluateConditions(conditions []models.Condition, j *models.AutomationJourney) (bool, map[string]interface{}) {
+	data := make(map[string]interface{}, len(conditions))
+	result := true
+	for _, c := range conditions {
+		val, ok := journeyField(j, c.Field)
+		data[c.Field] = val
+		if !matchCondition(c, val, ok) {
+			result = false
+		}
+	}
+	return result, data
+}
+
+// journeyField مقدار فیلد را از تعامل آخرین ایمیل یا داده‌های مشترک (Variables) برمی‌گرداند
+func journeyField(j *models.AutomationJourney, field string) (interface{}, bool) {
+	switch field {
+	case fieldEmailOpened, fieldEmailClicked:
+		last := j.LastEmailStep()
+		if last == nil {
+			return false, true
+		}
+		if field == fieldEmailOpened {
+			return !last.EmailOpenedAt.IsZero(), true
+		}
+		return !last.EmailClickedAt.IsZero(), true
+	case "email":
+		return j.SubscriberEmail, true
+	}
+	return lookupPath(j.Variables, field)
+}
+
+// lookupPath پشتیبانی از فیلدهای تو در تو مثل cart.total
+func lookupPath(vars map[string]interface{}, path string) (interface{}, bool) {
+	var cur interface{} = vars
+	for _, part := range strings.Split(path, ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if cur, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+func matchCondition(c models.Condition, val interface{}, exists bool) bool {
+	switch c.Operator {
+	case "exists", "is_set":
+		return exists && val != nil && toString(val) != ""
+	case "not_exists", "is_not_set":
+		return !exists || val == nil || toString(val) == ""
+	}
+	if !exists {
+		// فیلد ناموجود فقط شرط‌های منفی را برقرار می‌کند
+		return c.Operator == "not_equals" || c.Operator == "not_contains"
+	}
+
+	switch c.Operator {
+	case "equals", "":
+		return equalValues(val, c.Value)
+	case "not_equals":
+		return !equalValues(val, c.Value)
+	case "contains":
+		return strings.Contains(strings.ToLower(toString(val)), strings.ToLower(toString(c.Value)))
+	case "not_contains":
+		return !strings.Contains(strings.ToLower(toString(val)), strings.ToLower(toString(c.Value)))
+	case "starts_with":
+		return strings.HasPrefix(strings.ToLower(toString(val)), strings.ToLower(toString(c.Value)))
+	case "ends_with":
+		return strings.HasSuffix(strings.ToLower(toString(val)), strings.ToLower(toString(c.Value)))
+	case "greater_than", "less_than", "greater_or_equal", "less_or_equal":
+		return compareValues(c.Operator, val, c.Value)
+	case "in":
+		if list, ok := c.Value.([]interface{}); ok {
+			for _, item := range list {
+				if equalValues(val, item) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// matchEventConditions برای EventConditions تریگرها: هر کلید باید با مقدار داده رویداد برابر باشد
+func matchEventConditions(conditions map[string]interface{}, data map[string]interface{}) bool {
+	for field, expected := range conditions {
+		val, ok := lookupPath(data, field)
+		if !ok || !equalValues(val, expected) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalValues(a, b interface{}) bool {
+	if af, ok := toFloat(a); ok {
+		if bf, ok := toFloat(b); ok {
+			return af == bf
+		}
+	}
+	if ab, ok := a.(bool); ok {
+		if bb, err := strconv.ParseBool(toString(b)); err == nil {
+			return ab == bb
+		}
+	}
+	return strings.EqualFold(toString(a), toString(b))
+}
+
+// compareValues اعداد عددی و بقیه (مثلاً تاریخ RFC3339) به صورت زمانی یا رشته‌ای مقایسه می‌شوند
+func compareValues(op string, a, b interface{}) bool {
+	var cmp int
+	af, aok := toFloat(a)
+	bf, bok := toFloat(b)
+	switch {
+	case aok && bok:
+		cmp = compareFloat(af, bf)
+	default:
+		at, aerr := time.Parse(time.RFC3339, toString(a))
+		bt, berr := time.Parse(time.RFC3339, toString(b))
+		if aerr == nil && berr == nil {
+			cmp = at.Compare(bt)
+		} else {
+			cmp = strings.Compare(toString(a), toString(b))
+		}
+	}
+
+	switch op {
+	case "greater_than":
+		return cmp > 0
+	case "less_than":
+		return cmp < 0
+	case "greater_or_equal":
+		return cmp >= 0
+	default:
+		return cmp <= 0
+	}
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a > b:
+		return 1
+	case a < b:
+		return -1
+	}
+	return 0
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func toString(v interface{}) string {
+	switch s := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return s
+	case time.Time:
+		return s.Format(time.RFC3339)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,491 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"strings"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	journeyBatchSize   = 20
+	journeyLease       = 2 * time.Minute  // هر دسته باید قبل از این مدت ذخیره شود
+	journeyLeaseSlack  = 30 * time.Second // جریانی که تا این فاصله از پایان Lease شروع نشده به دور بعد می‌ماند
+	journeyPollEvery   = 15 * time.Second
+	maxStepsPerTick    = 20 // جلوگیری از حلقه بی‌پایان در گراف‌های اشتباه
+	maxEmailAttempts   = 3
+	emailRetryDelay    = 5 * time.Minute
+	inactiveRecheckGap = time.Hour // جریان‌های اتوماسیون غیرفعال با این فاصله دوباره بررسی می‌شوند
+)
+
+// کلیدهای RecipientData آیتم صف ایمیل‌های Journey (مانند recipientListVar)؛ campaignSender با آن‌ها مرحله را پیدا می‌کند
+const (
+	journeyAutomationVar = "automation_id"
+	journeyIDVar         = "journey_id"
+	journeyStepVar       = "journey_step_id"
+)
+
+type automationEngine struct {
+	automations port.IAutomationRepository
+	journeys    port.IJourneyRepository
+	stats       port.IAutomationStatsRepository // اختیاری؛ شمارنده‌های روزانه برای گزارش‌ها
+	queue       port.ISendQueue                 // ایمیل‌های مراحل مانند کمپین‌ها از صف ارسال و campaignSender فرستاده می‌شوند
+	workerID    string
+}
+
+// workerID باید برای هر نمونه سرویس یکتا باشد (مثلاً hostname-pid)
+func NewAutomationEngine(automations port.IAutomationRepository, journeys port.IJourneyRepository, stats port.IAutomationStatsRepository,
+	queue port.ISendQueue, workerID string) port.IAutomationEngine {
+	return &automationEngine{
+		automations: automations,
+		journeys:    journeys,
+		stats:       stats,
+		queue:       queue,
+		workerID:    workerID,
+	}
+}
+
+// Enroll: ساخت جریان جدید در اولین مرحله اتوماسیون
+func (e *automationEngine) Enroll(ctx context.Context, a *models.EmailAutomation, subscriberID primitive.ObjectID, email string,
+	variables map[string]interface{}, delay time.Duration) (*models.AutomationJourney, error) {
+	if !a.IsActive {
+		return nil, fmt.Errorf("automation %s is not active", a.ID.Hex())
+	}
+	first := a.FirstStep()
+	if first == nil {
+		return nil, fmt.Errorf("automation %s has no steps", a.ID.Hex())
+	}
+
+	now := time.Now()
+	j := newJourney(a, first, subscriberID, email, variables, now)
+	j.NextStepAt = now.Add(delay)
+	if err := e.journeys.CreateJourney(ctx, j); err != nil {
+		return nil, err
+	}
+	e.recordCounters(ctx, domain.AutomationCounters{AutomationID: a.ID.Hex(), Day: now, Entries: 1})
+	return j, nil
+}
+
+// newJourney جریان فعال در مرحله first
+func newJourney(a *models.EmailAutomation, first *models.AutomationStep, subscriberID primitive.ObjectID, email string,
+	variables map[string]interface{}, now time.Time) *models.AutomationJourney {
+	return &models.AutomationJourney{
+		ID:                primitive.NewObjectID(),
+		AutomationID:      a.ID,
+		AccountID:         a.AccountID,
+		SubscriberID:      subscriberID,
+		SubscriberEmail:   email,
+		CurrentStepID:     first.ID,
+		CurrentStepNumber: first.StepNumber,
+		Status:            models.JOURNEY_ACTIVE,
+		EnteredAt:         now,
+		LastUpdatedAt:     now,
+		NextStepAt:        now,
+		Variables:         variables,
+	}
+}
+
+func (e *automationEngine) Run(ctx context.Context) error {
+	ticker := time.NewTicker(journeyPollEvery)
+	defer ticker.Stop()
+	for {
+		// تا وقتی دسته کامل برمی‌گردد ادامه می‌دهیم تا عقب‌ماندگی جبران شود
+		for {
+			n, err := e.ProcessDue(ctx)
+			if err != nil {
+				log.Printf("⚠️ automation engine: %v", err)
+				break
+			}
+			if n < journeyBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *automationEngine) ProcessDue(ctx context.Context) (int, error) {
+	now := time.Now()
+	batch, err := e.journeys.ClaimDueJourneys(ctx, e.workerID, now, journeyBatchSize, journeyLease)
+	if err != nil {
+		return 0, err
+	}
+
+	// Lease همه جریان‌های دسته با هم تمام می‌شود؛ جریان‌های باقی‌مانده بعد از انقضا دوباره برداشته می‌شوند
+	deadline := now.Add(journeyLease - journeyLeaseSlack)
+	cache := make(map[primitive.ObjectID]*models.EmailAutomation)
+	for _, j := range batch {
+		if time.Now().After(deadline) {
+			log.Printf("⚠️ automation engine: lease of %d journeys is about to expire, leaving them for the next run", len(batch))
+			break
+		}
+		a, ok := cache[j.AutomationID]
+		if !ok {
+			if a, err = e.automations.GetAutomation(ctx, j.AccountID, j.AutomationID); err != nil {
+				a = nil
+			}
+			cache[j.AutomationID] = a
+		}
+
+		before := snapshotJourney(j)
+		if err := e.process(ctx, a, j, now); err != nil {
+			log.Printf("⚠️ automation journey %s: %v", j.ID.Hex(), err)
+		}
+		if err := e.journeys.SaveJourney(ctx, j, e.workerID); err != nil {
+			log.Printf("⚠️ automation journey %s: failed to save: %v", j.ID.Hex(), err)
+			continue // Worker دیگری جریان را دوباره پردازش می‌کند؛ شمارنده‌ها نباید دوبار ثبت شوند
+		}
+		e.recordCounters(ctx, journeyCounters(j, before, now)...)
+	}
+	return len(batch), nil
+}
+
+// process مراحل را تا رسیدن به یک انتظار (تأخیر، تلاش مجدد) یا پایان جریان اجرا می‌کند
+func (e *automationEngine) process(ctx context.Context, a *models.EmailAutomation, j *models.AutomationJourney, now time.Time) error {
+	j.LastUpdatedAt = now
+	if a == nil {
+		j.Status = models.JOURNEY_EXITED
+		j.CompletedAt = now
+		return errors.New("automation not found")
+	}
+	if !a.IsActive {
+		j.NextStepAt = now.Add(inactiveRecheckGap)
+		return nil
+	}
+	if err := e.applyEngagement(ctx, j); err != nil {
+		return err
+	}
+
+	for i := 0; i < maxStepsPerTick; i++ {
+		step := a.FindStep(j.CurrentStepID)
+		if step == nil {
+			j.Status = models.JOURNEY_EXITED
+			j.CompletedAt = now
+			return fmt.Errorf("step %s not found", j.CurrentStepID.Hex())
+		}
+
+		next, waitUntil, err := e.execute(ctx, a, j, step, now)
+		if err != nil {
+			return err
+		}
+		if j.Status != models.JOURNEY_ACTIVE {
+			return nil
+		}
+		if !waitUntil.IsZero() {
+			j.NextStepAt = waitUntil
+			return nil
+		}
+		if next == nil {
+			j.Status = models.JOURNEY_COMPLETED
+			j.CompletedAt = now
+			return nil
+		}
+		j.CurrentStepID = next.ID
+		j.CurrentStepNumber = next.StepNumber
+	}
+
+	// ادامه در دور بعدی
+	j.NextStepAt = now
+	return nil
+}
+
+// execute یک مرحله را اجرا می‌کند؛ waitUntil غیرصفر یعنی جریان تا آن زمان منتظر می‌ماند
+func (e *automationEngine) execute(ctx context.Context, a *models.EmailAutomation, j *models.AutomationJourney,
+	step *models.AutomationStep, now time.Time) (next *models.AutomationStep, waitUntil time.Time, err error) {
+
+	entry := models.JourneyStep{
+		StepID:     step.ID,
+		StepNumber: step.StepNumber,
+		StepType:   step.StepType,
+		EnteredAt:  now,
+		Status:     models.JOURNEY_STEP_COMPLETED,
+	}
+
+	switch step.StepType {
+	case models.STEP_DELAY:
+		if last := lastHistory(j); last != nil && last.StepID == step.ID && last.Status == models.JOURNEY_STEP_WAITING {
+			if now.Before(last.ScheduledEndAt) {
+				return nil, last.ScheduledEndAt, nil
+			}
+			last.Status = models.JOURNEY_STEP_COMPLETED
+			last.CompletedAt = now
+			return a.NextStep(step), time.Time{}, nil
+		}
+		entry.Status = models.JOURNEY_STEP_WAITING
+		entry.ScheduledEndAt = delayEnd(step, journeyWindow(a, j).loc, now)
+		entry.DelayDuration = entry.ScheduledEndAt.Sub(now)
+		j.StepHistory = append(j.StepHistory, entry)
+		return nil, entry.ScheduledEndAt, nil
+
+	case models.STEP_EMAIL:
+		entry.MessageID = step.MessageID
+		// خارج از پنجره ارسال: تا اولین زمان مجاز به تعویق می‌افتد و در ScheduledEndAt دیده می‌شود
+		last := lastHistory(j)
+		deferred := last != nil && last.StepID == step.ID && last.Status == models.JOURNEY_STEP_WAITING
+		if slot := journeyWindow(a, j).nextSlot(now); slot.After(now) {
+			if deferred {
+				last.ScheduledEndAt = slot
+				last.DelayDuration = slot.Sub(last.EnteredAt)
+				return nil, slot, nil
+			}
+			entry.Status = models.JOURNEY_STEP_WAITING
+			entry.ScheduledEndAt = slot
+			entry.DelayDuration = slot.Sub(now)
+			j.StepHistory = append(j.StepHistory, entry)
+			return nil, slot, nil
+		}
+		if deferred {
+			entry.EnteredAt = last.EnteredAt
+			entry.ScheduledEndAt = last.ScheduledEndAt
+			entry.DelayDuration = last.DelayDuration
+		}
+		itemID, sendErr := e.enqueueEmail(ctx, a, j, step)
+		if sendErr != nil {
+			entry.Status = models.JOURNEY_STEP_FAILED
+			entry.ErrorMessage = sendErr.Error()
+		} else {
+			entry.DeliveryID = itemID
+			entry.EmailStatus = "queued"
+		}
+		entry.CompletedAt = now
+		if deferred {
+			*last = entry // ورودی تعویق با نتیجه ارسال کامل می‌شود
+		} else {
+			j.StepHistory = append(j.StepHistory, entry)
+		}
+		// بعد از چند تلاش ناموفق مرحله رد می‌شود تا جریان متوقف نماند
+		if sendErr != nil && failedAttempts(j, step.ID) < maxEmailAttempts {
+			return nil, now.Add(emailRetryDelay), nil
+		}
+		return a.NextStep(step), time.Time{}, nil
+
+	case models.STEP_CONDITION:
+		result, data := evaluateConditions(step.Conditions, j)
+		entry.ConditionResult = result
+		entry.ConditionData = data
+		target := step.NegativeStepID
+		if result {
+			target = step.PositiveStepID
+		}
+		next = a.NextStep(step)
+		if !target.IsZero() {
+			next = a.FindStep(target)
+		}
+		entry.CompletedAt = now
+		j.StepHistory = append(j.StepHistory, entry)
+		return next, time.Time{}, nil
+
+	case models.STEP_SPLIT:
+		group := splitGroup(step, j.SubscriberEmail)
+		entry.SplitGroup = group
+		entry.CompletedAt = now
+		j.StepHistory = append(j.StepHistory, entry)
+		if group < 0 {
+			return a.NextStep(step), time.Time{}, nil
+		}
+		return a.FindStep(step.SplitStepIDs[group]), time.Time{}, nil
+
+	case models.STEP_EXIT:
+		entry.CompletedAt = now
+		j.StepHistory = append(j.StepHistory, entry)
+		j.Status = models.JOURNEY_EXITED
+		j.CompletedAt = now
+		return nil, time.Time{}, nil
+
+	default:
+		entry.Status = models.JOURNEY_STEP_SKIPPED
+		entry.ErrorMessage = "unknown step type " + step.StepType
+	}
+
+	entry.CompletedAt = now
+	j.StepHistory = append(j.StepHistory, entry)
+	return a.NextStep(step), time.Time{}, nil
+}
+
+// enqueueEmail ایمیل مرحله را به صف ارسال می‌دهد تا مانند کمپین‌ها با بررسی Suppression، محدودیت نرخ، سرآیند لغو عضویت،
+// رهگیری و UTM فرستاده شود؛ رندر محتوا هنگام ارسال انجام می‌شود (campaignSender.handleJourney)
+func (e *automationEngine) enqueueEmail(ctx context.Context, a *models.EmailAutomation, j *models.AutomationJourney,
+	step *models.AutomationStep) (primitive.ObjectID, error) {
+	if step.MessageID.IsZero() && step.CampaignID == "" {
+		return primitive.NilObjectID, errors.New("email step has no message")
+	}
+	data := make(map[string]interface{}, len(j.Variables)+3)
+	for k, v := range j.Variables {
+		data[k] = v
+	}
+	data[journeyAutomationVar] = a.ID.Hex()
+	data[journeyIDVar] = j.ID.Hex()
+	data[journeyStepVar] = step.ID.Hex()
+
+	// ایمیل‌های اتوماسیون پاسخ به رفتار مشترک هستند و جلوتر از ارسال‌های انبوه کمپین‌ها قرار می‌گیرند
+	item := &models.QueueItem{
+		MessageID:      step.MessageID,
+		RecipientEmail: j.SubscriberEmail,
+		RecipientData:  data,
+		Priority:       models.PRIORITY_HIGH,
+	}
+	if _, err := e.queue.Enqueue(ctx, j.AccountID, a.ID, []*models.QueueItem{item}); err != nil {
+		return primitive.NilObjectID, err
+	}
+	return item.ID, nil
+}
+
+func (e *automationEngine) RecordEngagement(ctx context.Context, accountID, journeyID, stepID, eventType string, at time.Time) error {
+	jid, err := primitive.ObjectIDFromHex(journeyID)
+	if err != nil {
+		return errors.New("invalid journey id")
+	}
+	j, err := e.journeys.GetJourney(ctx, accountID, jid)
+	if err != nil {
+		return err
+	}
+
+	var sid primitive.ObjectID
+	if stepID != "" {
+		if sid, err = primitive.ObjectIDFromHex(stepID); err != nil {
+			return errors.New("invalid step id")
+		}
+	} else if last := j.LastEmailStep(); last != nil {
+		sid = last.StepID
+	} else {
+		return errors.New("journey has no email step")
+	}
+
+	switch eventType {
+	case "opened", "clicked":
+	default:
+		return fmt.Errorf("unsupported engagement event %q", eventType)
+	}
+	first, err := e.journeys.RecordEngagement(ctx, jid, sid, eventType, at)
+	if err != nil || !first {
+		return err
+	}
+	c := domain.AutomationCounters{AutomationID: j.AutomationID.Hex(), StepID: sid.Hex(), Day: at}
+	if eventType == "clicked" {
+		c.EmailsClicked = 1
+	} else {
+		c.EmailsOpened = 1
+	}
+	e.recordCounters(ctx, c)
+	return nil
+}
+
+// applyEngagement رویدادهای باز شدن/کلیک ثبت شده را روی StepHistory اعمال می‌کند
+func (e *automationEngine) applyEngagement(ctx context.Context, j *models.AutomationJourney) error {
+	events, err := e.journeys.ListEngagement(ctx, j.ID)
+	if err != nil {
+		return err
+	}
+	for _, ev := range events {
+		for i := len(j.StepHistory) - 1; i >= 0; i-- {
+			h := &j.StepHistory[i]
+			if h.StepID != ev.StepID || h.StepType != models.STEP_EMAIL || h.Status != models.JOURNEY_STEP_COMPLETED {
+				continue
+			}
+			if !ev.EmailOpenedAt.IsZero() && h.EmailOpenedAt.IsZero() {
+				h.EmailOpenedAt = ev.EmailOpenedAt
+				h.EmailStatus = "opened"
+			}
+			if !ev.EmailClickedAt.IsZero() && h.EmailClickedAt.IsZero() {
+				h.EmailClickedAt = ev.EmailClickedAt
+				h.EmailStatus = "clicked"
+				// کلیک بدون باز شدن ثبت شده (مثلاً تصاویر مسدود) یعنی باز شده است
+				if h.EmailOpenedAt.IsZero() {
+					h.EmailOpenedAt = ev.EmailClickedAt
+				}
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// lastHistory آخرین ورودی اجرای مرحله (ورودی‌های اقدام دستی نادیده گرفته می‌شوند)
+func lastHistory(j *models.AutomationJourney) *models.JourneyStep {
+	if i := lastHistoryIndex(j, len(j.StepHistory)); i >= 0 {
+		return &j.StepHistory[i]
+	}
+	return nil
+}
+
+// lastHistoryIndex اندیس آخرین ورودی غیر دستی قبل از before (-1 اگر نباشد)
+func lastHistoryIndex(j *models.AutomationJourney, before int) int {
+	for i := before - 1; i >= 0; i-- {
+		if j.StepHistory[i].StepType != models.STEP_MANUAL {
+			return i
+		}
+	}
+	return -1
+}
+
+// failedAttempts تعداد تلاش‌های ناموفق پشت سر هم برای یک مرحله
+func failedAttempts(j *models.AutomationJourney, stepID primitive.ObjectID) int {
+	n := 0
+	for i := len(j.StepHistory) - 1; i >= 0; i-- {
+		h := j.StepHistory[i]
+		if h.StepType == models.STEP_MANUAL {
+			continue
+		}
+		if h.StepID != stepID || h.Status != models.JOURNEY_STEP_FAILED {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// splitGroup شاخه مشترک در مرحله تقسیم؛ با هش ایمیل و شناسه مرحله قطعی است
+// تا ورود دوباره یا پردازش مجدد بعد از Restart همان شاخه را بدهد (نسبت خالی یعنی تقسیم مساوی)
+func splitGroup(step *models.AutomationStep, email string) int {
+	branches := len(step.SplitStepIDs)
+	if branches == 0 {
+		return -1
+	}
+	if !step.SplitWinnerStepID.IsZero() {
+		if winner := step.SplitBranch(step.SplitWinnerStepID); winner >= 0 {
+			return winner
+		}
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(step.ID.Hex()))
+	h.Write([]byte{0})
+	h.Write([]byte(strings.ToLower(strings.TrimSpace(email))))
+	point := float64(h.Sum64()>>11) / (1 << 53) // عدد یکنواخت در [0, 1)
+
+	total := 0.0
+	for i := 0; i < branches && i < len(step.SplitRatio); i++ {
+		if step.SplitRatio[i] > 0 {
+			total += step.SplitRatio[i]
+		}
+	}
+	if total <= 0 {
+		return int(point * float64(branches))
+	}
+	r := point * total
+	last := 0
+	for i := 0; i < branches && i < len(step.SplitRatio); i++ {
+		if step.SplitRatio[i] <= 0 {
+			continue
+		}
+		if r < step.SplitRatio[i] {
+			return i
+		}
+		r -= step.SplitRatio[i]
+		last = i
+	}
+	return last // خطای گرد کردن اعشار
+}
@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	manualLease        = 30 * time.Second // Lease اقدام دستی؛ فقط تا ذخیره جریان نگه داشته می‌شود
+	bulkStatusAttempts = 5                // تلاش دوباره برای جریان‌هایی که در حال پردازش توسط Worker هستند
+	bulkStatusRetryGap = 2 * time.Second
+)
+
+type journeyServices struct {
+	automations port.IAutomationRepository
+	journeys    port.IJourneyRepository
+	stats       port.IAutomationStatsRepository
+}
+
+func NewJourneyServices(automations port.IAutomationRepository, journeys port.IJourneyRepository,
+	stats port.IAutomationStatsRepository) port.IJourneyService {
+	return &journeyServices{automations: automations, journeys: journeys, stats: stats}
+}
+
+func (s *journeyServices) ListJourneys(ctx context.Context, accountID string, filter domain.JourneyFilter, limit, offset int) ([]*models.AutomationJourney, int, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	filter.Email = strings.ToLower(strings.TrimSpace(filter.Email))
+	return s.journeys.ListJourneys(ctx, accountID, filter, limit, offset)
+}
+
+func (s *journeyServices) PauseJourney(ctx context.Context, accountID, journeyID string, action domain.JourneyAction) (*models.AutomationJourney, error) {
+	return s.update(ctx, accountID, journeyID, models.JOURNEY_ACTION_PAUSE, action, func(j *models.AutomationJourney, now time.Time) error {
+		if j.Status != models.JOURNEY_ACTIVE {
+			return fmt.Errorf("only active journeys can be paused (status: %s)", j.Status)
+		}
+		j.Status = models.JOURNEY_PAUSED
+		return nil
+	})
+}
+
+func (s *journeyServices) ResumeJourney(ctx context.Context, accountID, journeyID string, action domain.JourneyAction) (*models.AutomationJourney, error) {
+	return s.update(ctx, accountID, journeyID, models.JOURNEY_ACTION_RESUME, action, func(j *models.AutomationJourney, now time.Time) error {
+		if j.Status != models.JOURNEY_PAUSED {
+			return fmt.Errorf("only paused journeys can be resumed (status: %s)", j.Status)
+		}
+		j.Status = models.JOURNEY_ACTIVE
+		j.NextStepAt = now
+		return nil
+	})
+}
+
+func (s *journeyServices) ExitJourney(ctx context.Context, accountID, journeyID string, action domain.JourneyAction) (*models.AutomationJourney, error) {
+	if strings.TrimSpace(action.Reason) == "" {
+		return nil, errors.New("a reason is required to exit a journey")
+	}
+	j, err := s.update(ctx, accountID, journeyID, models.JOURNEY_ACTION_EXIT, action, func(j *models.AutomationJourney, now time.Time) error {
+		if j.Status != models.JOURNEY_ACTIVE && j.Status != models.JOURNEY_PAUSED {
+			return fmt.Errorf("journey has already ended (status: %s)", j.Status)
+		}
+		skipWaiting(j, now)
+		j.Status = models.JOURNEY_EXITED
+		j.CompletedAt = now
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.recordCounters(ctx,
+		domain.AutomationCounters{AutomationID: j.AutomationID.Hex(), Day: j.CompletedAt, Exited: 1},
+		domain.AutomationCounters{AutomationID: j.AutomationID.Hex(), StepID: j.CurrentStepID.Hex(), Day: j.CompletedAt, DropOffs: 1})
+	return j, nil
+}
+
+func (s *journeyServices) MoveJourney(ctx context.Context, accountID, journeyID, stepID string, action domain.JourneyAction) (*models.AutomationJourney, error) {
+	sid, err := primitive.ObjectIDFromHex(stepID)
+	if err != nil {
+		return nil, errors.New("invalid step id")
+	}
+	return s.update(ctx, accountID, journeyID, models.JOURNEY_ACTION_MOVE, action, func(j *models.AutomationJourney, now time.Time) error {
+		if j.Status != models.JOURNEY_ACTIVE && j.Status != models.JOURNEY_PAUSED {
+			return fmt.Errorf("journey has already ended (status: %s); re-enroll the subscriber instead", j.Status)
+		}
+		a, err := s.automations.GetAutomation(ctx, accountID, j.AutomationID)
+		if err != nil {
+			return err
+		}
+		target := a.FindStep(sid)
+		if target == nil {
+			return errors.New("step not found in automation")
+		}
+		skipWaiting(j, now)
+		j.CurrentStepID = target.ID
+		j.CurrentStepNumber = target.StepNumber
+		j.NextStepAt = now
+		return nil
+	})
+}
+
+func (s *journeyServices) ReenrollSubscriber(ctx context.Context, accountID, automationID, email string, action domain.JourneyAction) (*models.AutomationJourney, error) {
+	aid, err := primitive.ObjectIDFromHex(automationID)
+	if err != nil {
+		return nil, errors.New("invalid automation id")
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return nil, errors.New("email is required")
+	}
+	a, err := s.automations.GetAutomation(ctx, accountID, aid)
+	if err != nil {
+		return nil, err
+	}
+	if !a.IsActive {
+		return nil, errors.New("automation is not active")
+	}
+	first := a.FirstStep()
+	if first == nil {
+		return nil, errors.New("automation has no steps")
+	}
+
+	active, err := s.journeys.HasActiveJourney(ctx, aid, email)
+	if err != nil {
+		return nil, err
+	}
+	if active {
+		return nil, errors.New("subscriber is still in this automation; exit the current journey first")
+	}
+
+	// مشخصات مشترک از آخرین جریان قبلی
+	var subscriberID primitive.ObjectID
+	var vars map[string]interface{}
+	previous, _, err := s.journeys.ListJourneys(ctx, accountID, domain.JourneyFilter{AutomationID: automationID, Email: email}, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(previous) > 0 {
+		subscriberID = previous[0].SubscriberID
+		vars = previous[0].Variables
+	}
+
+	now := time.Now()
+	j := newJourney(a, first, subscriberID, email, vars, now)
+	j.StepHistory = append(j.StepHistory, manualEntry(j, models.JOURNEY_ACTION_REENROLL, action, now))
+	if err := s.journeys.CreateJourney(ctx, j); err != nil {
+		return nil, err
+	}
+	s.recordCounters(ctx, domain.AutomationCounters{AutomationID: a.ID.Hex(), Day: now, Entries: 1})
+	return j, nil
+}
+
+func (s *journeyServices) PauseAutomationJourneys(ctx context.Context, accountID, automationID string, action domain.JourneyAction) (int, error) {
+	return s.setAll(ctx, accountID, automationID, models.JOURNEY_ACTIVE, models.JOURNEY_PAUSED, models.JOURNEY_ACTION_PAUSE, action)
+}
+
+func (s *journeyServices) ResumeAutomationJourneys(ctx context.Context, accountID, automationID string, action domain.JourneyAction) (int, error) {
+	return s.setAll(ctx, accountID, automationID, models.JOURNEY_PAUSED, models.JOURNEY_ACTIVE, models.JOURNEY_ACTION_RESUME, action)
+}
+
+// ---------------------------------------------------------
+// توابع کمکی
+// ---------------------------------------------------------
+
+// update جریان را با Lease دستی قفل، تغییر و همراه ورودی اقدام در StepHistory ذخیره می‌کند
+func (s *journeyServices) update(ctx context.Context, accountID, journeyID, actionName string, action domain.JourneyAction,
+	apply func(j *models.AutomationJourney, now time.Time) error) (*models.AutomationJourney, error) {
+	id, err := primitive.ObjectIDFromHex(journeyID)
+	if err != nil {
+		return nil, errors.New("invalid journey id")
+	}
+
+	owner := "manual-" + primitive.NewObjectID().Hex()
+	now := time.Now()
+	j, err := s.journeys.LockJourney(ctx, accountID, id, owner, now, manualLease)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := apply(j, now); err != nil {
+		// آزادسازی Lease بدون تغییر
+		if _, saveErr := s.save(ctx, j, owner); saveErr != nil {
+			return nil, fmt.Errorf("%v (unlock failed: %v)", err, saveErr)
+		}
+		return nil, err
+	}
+	j.LastUpdatedAt = now
+	j.StepHistory = append(j.StepHistory, manualEntry(j, actionName, action, now))
+	return s.save(ctx, j, owner)
+}
+
+func (s *journeyServices) save(ctx context.Context, j *models.AutomationJourney, owner string) (*models.AutomationJourney, error) {
+	if err := s.journeys.SaveJourney(ctx, j, owner); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// setAll تغییر وضعیت همه جریان‌ها؛ جریان‌هایی که Worker در حال پردازش آن‌هاست بعد از کمی صبر دوباره امتحان می‌شوند
+func (s *journeyServices) setAll(ctx context.Context, accountID, automationID, from, to, actionName string, action domain.JourneyAction) (int, error) {
+	aid, err := primitive.ObjectIDFromHex(automationID)
+	if err != nil {
+		return 0, errors.New("invalid automation id")
+	}
+	if _, err := s.automations.GetAutomation(ctx, accountID, aid); err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for attempt := 0; attempt < bulkStatusAttempts; attempt++ {
+		now := time.Now()
+		// StepID و StepNumber توسط Repository با مرحله فعلی هر جریان پر می‌شوند
+		entry := manualEntry(&models.AutomationJourney{}, actionName, action, now)
+		updated, busy, err := s.journeys.SetJourneysStatus(ctx, accountID, aid, from, to, entry, now)
+		if err != nil {
+			return total, err
+		}
+		total += updated
+		if busy == 0 {
+			return total, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return total, ctx.Err()
+		case <-time.After(bulkStatusRetryGap):
+		}
+	}
+	return total, errors.New("some journeys are being processed and were not changed; try again")
+}
+
+// manualEntry ورودی StepHistory برای اقدام دستی
+func manualEntry(j *models.AutomationJourney, actionName string, action domain.JourneyAction, now time.Time) models.JourneyStep {
+	return models.JourneyStep{
+		StepID:      j.CurrentStepID,
+		StepNumber:  j.CurrentStepNumber,
+		StepType:    models.STEP_MANUAL,
+		EnteredAt:   now,
+		CompletedAt: now,
+		Status:      models.JOURNEY_STEP_COMPLETED,
+		Variables: map[string]interface{}{
+			"action": actionName,
+			"actor":  action.Actor,
+			"reason": action.Reason,
+		},
+	}
+}
+
+// skipWaiting تأخیر یا ایمیل به تعویق افتاده‌ای که با جابه‌جایی/خروج دیگر اجرا نمی‌شود
+func skipWaiting(j *models.AutomationJourney, now time.Time) {
+	if last := lastHistory(j); last != nil && last.Status == models.JOURNEY_STEP_WAITING {
+		last.Status = models.JOURNEY_STEP_SKIPPED
+		last.CompletedAt = now
+	}
+}
+
+func (s *journeyServices) recordCounters(ctx context.Context, counters ...domain.AutomationCounters) {
+	if s.stats == nil {
+		return
+	}
+	if err := s.stats.IncrementCounters(ctx, counters); err != nil {
+		log.Printf("⚠️ automation stats: %v", err)
+	}
+}
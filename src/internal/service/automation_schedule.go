@@ -0,0 +1,139 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+)
+
+// کلید متغیر جریان برای منطقه زمانی مشترک (مثلاً "Asia/Tehran")
+const journeyTimezoneVar = "timezone"
+
+// sendingWindow پنجره مجاز ارسال بر اساس SendingHours / SendingDays در یک منطقه زمانی
+type sendingWindow struct {
+	loc   *time.Location
+	hours []int        // ساعت‌های مجاز به وقت محلی (۰ تا ۲۳)، مرتب؛ خالی یعنی همه ساعت‌ها
+	days  map[int]bool // روزهای مجاز هفته (time.Weekday، یکشنبه = ۰)؛ خالی یعنی همه روزها
+}
+
+// journeyWindow پنجره ارسال جریان: منطقه زمانی مشترک، در غیر این صورت منطقه زمانی اتوماسیون و در نهایت UTC
+func journeyWindow(a *models.EmailAutomation, j *models.AutomationJourney) sendingWindow {
+	w := sendingWindow{loc: time.UTC}
+	if loc, err := time.LoadLocation(a.Settings.Timezone); err == nil && a.Settings.Timezone != "" {
+		w.loc = loc
+	}
+	if tz := toString(j.Variables[journeyTimezoneVar]); tz != "" {
+		if loc, err := time.LoadLocation(tz); err == nil {
+			w.loc = loc
+		}
+	}
+
+	seen := make(map[int]bool, len(a.Settings.SendingHours))
+	for _, h := range a.Settings.SendingHours {
+		if h >= 0 && h <= 23 && !seen[h] {
+			seen[h] = true
+			w.hours = append(w.hours, h)
+		}
+	}
+	sort.Ints(w.hours)
+
+	if len(a.Settings.SendingDays) > 0 {
+		w.days = make(map[int]bool, len(a.Settings.SendingDays))
+		for _, d := range a.Settings.SendingDays {
+			if d >= 0 && d <= 6 {
+				w.days[d] = true
+			}
+		}
+	}
+	return w
+}
+
+func (w sendingWindow) unrestricted() bool {
+	return len(w.hours) == 0 && len(w.days) == 0
+}
+
+func (w sendingWindow) allows(t time.Time) bool {
+	local := t.In(w.loc)
+	if len(w.days) > 0 && !w.days[int(local.Weekday())] {
+		return false
+	}
+	if len(w.hours) == 0 {
+		return true
+	}
+	for _, h := range w.hours {
+		if h == local.Hour() {
+			return true
+		}
+	}
+	return false
+}
+
+// nextSlot اولین لحظه مجاز ارسال از now به بعد
+//
+// ساعت‌ها بر اساس ساعت دیواری محلی محاسبه می‌شوند تا با تغییر ساعت تابستانی جابه‌جا نشوند؛
+// ساعتی که در روز تغییر ساعت وجود ندارد (مثلاً ۰۲:۰۰ در بهار) به پایان فاصله منتقل می‌شود
+// و ساعت تکراری پاییز اولین وقوع خود را می‌گیرد.
+func (w sendingWindow) nextSlot(now time.Time) time.Time {
+	if w.unrestricted() || w.allows(now) {
+		return now
+	}
+
+	hours := w.hours
+	if len(hours) == 0 {
+		hours = []int{0}
+	}
+	local := now.In(w.loc)
+	for offset := 0; offset <= 7; offset++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, w.loc)
+		if len(w.days) > 0 && !w.days[int(day.Weekday())] {
+			continue
+		}
+		for _, h := range hours {
+			slot := time.Date(day.Year(), day.Month(), day.Day(), h, 0, 0, 0, w.loc)
+			if slot.Hour() != h {
+				// ساعت در فاصله تغییر ساعت وجود ندارد؛ time.Date آن را به قبل از فاصله می‌برد
+				slot = time.Date(day.Year(), day.Month(), day.Day(), h+1, 0, 0, 0, w.loc)
+			}
+			if slot.After(now) {
+				return slot
+			}
+		}
+	}
+	// فقط وقتی رخ می‌دهد که هیچ روز معتبری تنظیم نشده باشد (مثلاً همه مقادیر خارج از بازه)
+	return now
+}
+
+// validateSendingSettings تنظیمات پنجره ارسال اتوماسیون را بررسی می‌کند
+func validateSendingSettings(s models.AutomationSettings) error {
+	if s.Timezone != "" {
+		if _, err := time.LoadLocation(s.Timezone); err != nil {
+			return fmt.Errorf("invalid timezone %q", s.Timezone)
+		}
+	}
+	for _, h := range s.SendingHours {
+		if h < 0 || h > 23 {
+			return fmt.Errorf("invalid sending hour %d (expected 0-23)", h)
+		}
+	}
+	for _, d := range s.SendingDays {
+		if d < 0 || d > 6 {
+			return fmt.Errorf("invalid sending day %d (expected 0-6, Sunday = 0)", d)
+		}
+	}
+	return nil
+}
+
+// delayEnd پایان مرحله تأخیر؛ تأخیرهای روزانه/هفتگی بر اساس تقویم محلی محاسبه می‌شوند
+// تا «۱ روز» در روز تغییر ساعت تابستانی همان ساعت دیواری فردا باشد (نه دقیقاً ۲۴ ساعت)
+func delayEnd(step *models.AutomationStep, loc *time.Location, from time.Time) time.Time {
+	switch step.DelayUnit {
+	case "minutes", "hours":
+		return from.Add(step.DelayDuration())
+	case "weeks":
+		return from.In(loc).AddDate(0, 0, 7*step.DelayTime)
+	default:
+		return from.In(loc).AddDate(0, 0, step.DelayTime)
+	}
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+)
+
+func TestSendingWindowNextSlot(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	local := func(y int, m time.Month, d, h, min int) time.Time {
+		return time.Date(y, m, d, h, min, 0, 0, ny)
+	}
+
+	tests := []struct {
+		name  string
+		hours []int
+		days  []int
+		now   time.Time
+		want  time.Time
+	}{
+		{
+			name: "unrestricted window sends now",
+			now:  local(2026, 10, 19, 3, 15),
+			want: local(2026, 10, 19, 3, 15),
+		},
+		{
+			name:  "inside an allowed hour sends now",
+			hours: []int{9, 17},
+			now:   local(2026, 10, 19, 9, 40),
+			want:  local(2026, 10, 19, 9, 40),
+		},
+		{
+			name:  "waits for the next allowed hour the same day",
+			hours: []int{17, 9},
+			now:   local(2026, 10, 19, 10, 0),
+			want:  local(2026, 10, 19, 17, 0),
+		},
+		{
+			name:  "after the last hour moves to the first hour tomorrow",
+			hours: []int{9, 17},
+			now:   local(2026, 10, 19, 18, 0),
+			want:  local(2026, 10, 20, 9, 0),
+		},
+		{
+			name: "days only waits for the start of the next allowed day",
+			days: []int{1}, // دوشنبه
+			now:  local(2026, 10, 17, 12, 0),
+			want: local(2026, 10, 19, 0, 0),
+		},
+		{
+			name:  "hours and days skip disallowed days",
+			hours: []int{9},
+			days:  []int{1, 3},
+			now:   local(2026, 10, 19, 10, 0),
+			want:  local(2026, 10, 21, 9, 0),
+		},
+		{
+			name:  "missing hour on spring forward moves to the end of the gap",
+			hours: []int{2},
+			now:   local(2026, 3, 8, 0, 30),
+			want:  time.Date(2026, 3, 8, 7, 0, 0, 0, time.UTC), // ۰۳:۰۰ EDT
+		},
+		{
+			name:  "repeated hour on fall back takes its first occurrence",
+			hours: []int{1},
+			now:   local(2026, 11, 1, 0, 30),
+			want:  time.Date(2026, 11, 1, 5, 0, 0, 0, time.UTC), // ۰۱:۰۰ EDT
+		},
+		{
+			name:  "out of range days are ignored",
+			hours: []int{9},
+			days:  []int{7},
+			now:   local(2026, 10, 19, 10, 0),
+			want:  local(2026, 10, 20, 9, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &models.EmailAutomation{Settings: models.AutomationSettings{
+				Timezone: "America/New_York", SendingHours: tt.hours, SendingDays: tt.days}}
+			got := journeyWindow(a, &models.AutomationJourney{}).nextSlot(tt.now)
+			if !got.Equal(tt.want) {
+				t.Errorf("nextSlot(%v) = %v, want %v", tt.now, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJourneyWindowTimezone(t *testing.T) {
+	a := &models.EmailAutomation{Settings: models.AutomationSettings{Timezone: "America/New_York", SendingHours: []int{9}}}
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		tz   interface{}
+		want time.Time
+	}{
+		{name: "automation timezone", tz: nil, want: time.Date(2026, 10, 19, 13, 0, 0, 0, time.UTC)},
+		{name: "contact timezone wins", tz: "Asia/Tehran", want: time.Date(2026, 10, 20, 5, 30, 0, 0, time.UTC)},
+		{name: "invalid contact timezone is ignored", tz: "Mars/Olympus", want: time.Date(2026, 10, 19, 13, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := &models.AutomationJourney{Variables: map[string]interface{}{}}
+			if tt.tz != nil {
+				j.Variables[journeyTimezoneVar] = tt.tz
+			}
+			if got := journeyWindow(a, j).nextSlot(now); !got.Equal(tt.want) {
+				t.Errorf("nextSlot() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if w := journeyWindow(&models.EmailAutomation{}, &models.AutomationJourney{}); w.loc != time.UTC || !w.unrestricted() {
+		t.Errorf("empty settings: loc = %v, unrestricted = %v; want UTC, true", w.loc, w.unrestricted())
+	}
+}
+
+func TestDelayEnd(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// روز قبل از تغییر ساعت بهاری ۲۰۲۶
+	from := time.Date(2026, 3, 7, 9, 0, 0, 0, ny)
+
+	tests := []struct {
+		unit  string
+		delay int
+		want  time.Time
+	}{
+		{unit: "minutes", delay: 90, want: from.Add(90 * time.Minute)},
+		{unit: "hours", delay: 24, want: from.Add(24 * time.Hour)},
+		{unit: "days", delay: 1, want: time.Date(2026, 3, 8, 9, 0, 0, 0, ny)},
+		{unit: "", delay: 2, want: time.Date(2026, 3, 9, 9, 0, 0, 0, ny)},
+		{unit: "weeks", delay: 1, want: time.Date(2026, 3, 14, 9, 0, 0, 0, ny)},
+	}
+	for _, tt := range tests {
+		step := &models.AutomationStep{DelayUnit: tt.unit, DelayTime: tt.delay}
+		if got := delayEnd(step, ny, from); !got.Equal(tt.want) {
+			t.Errorf("delayEnd(%d %q) = %v, want %v", tt.delay, tt.unit, got, tt.want)
+		}
+	}
+
+	// «۱ روز» در روز تغییر ساعت ۲۳ ساعت است، نه ۲۴
+	if got := delayEnd(&models.AutomationStep{DelayUnit: "days", DelayTime: 1}, ny, from); got.Sub(from) != 23*time.Hour {
+		t.Errorf("1 day across spring forward = %v, want 23h", got.Sub(from))
+	}
+}
+
+func TestValidateSendingSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       models.AutomationSettings
+		wantErr bool
+	}{
+		{name: "empty", s: models.AutomationSettings{}},
+		{name: "valid", s: models.AutomationSettings{Timezone: "Asia/Tehran", SendingHours: []int{0, 23}, SendingDays: []int{0, 6}}},
+		{name: "bad timezone", s: models.AutomationSettings{Timezone: "Mars/Olympus"}, wantErr: true},
+		{name: "bad hour", s: models.AutomationSettings{SendingHours: []int{24}}, wantErr: true},
+		{name: "bad day", s: models.AutomationSettings{SendingDays: []int{-1}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		if err := validateSendingSettings(tt.s); (err != nil) != tt.wantErr {
+			t.Errorf("%s: validateSendingSettings() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,437 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var validDelayUnits = map[string]bool{"": true, "minutes": true, "hours": true, "days": true, "weeks": true}
+
+type automationServices struct {
+	repo      port.IAutomationRepository
+	journeys  port.IJourneyRepository
+	stats     port.IAutomationStatsRepository
+	campaigns port.ICampaignRepository
+}
+
+func NewAutomationServices(repo port.IAutomationRepository, journeys port.IJourneyRepository, stats port.IAutomationStatsRepository,
+	campaigns port.ICampaignRepository) port.IAutomationService {
+	return &automationServices{repo: repo, journeys: journeys, stats: stats, campaigns: campaigns}
+}
+
+// CreateAutomation: اتوماسیون همیشه غیرفعال ساخته می‌شود و با ActivateAutomation شروع به کار می‌کند
+func (s *automationServices) CreateAutomation(ctx context.Context, a *models.EmailAutomation) (*models.EmailAutomation, error) {
+	if err := validateAutomation(a); err != nil {
+		return nil, err
+	}
+
+	a.ID = primitive.NewObjectID()
+	a.IsActive = false
+	a.CreatedAt = time.Now()
+	a.UpdatedAt = a.CreatedAt
+	a.Statistics = models.AutomationStats{}
+	keepStepResults(&models.EmailAutomation{}, a)
+
+	if err := s.linkCampaigns(ctx, a.AccountID, linkedCampaigns(a)); err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateAutomation(ctx, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (s *automationServices) UpdateAutomation(ctx context.Context, a *models.EmailAutomation) (*models.EmailAutomation, error) {
+	existing, err := s.repo.GetAutomation(ctx, a.AccountID, a.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.IsActive {
+		return nil, errors.New("deactivate the automation before editing it")
+	}
+	if err := validateAutomation(a); err != nil {
+		return nil, err
+	}
+
+	a.IsActive = false
+	a.CreatedAt = existing.CreatedAt
+	a.UpdatedAt = time.Now()
+	a.Statistics = existing.Statistics
+	keepStepResults(existing, a)
+
+	newLinks := linkedCampaigns(a)
+	if err := s.linkCampaigns(ctx, a.AccountID, newLinks); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateAutomation(ctx, a); err != nil {
+		return nil, err
+	}
+
+	if err := s.unlinkCampaigns(ctx, a.AccountID, missing(linkedCampaigns(existing), newLinks)); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// GetAutomation آمار اتوماسیون و مراحل را از شمارنده‌های روزانه محاسبه می‌کند
+func (s *automationServices) GetAutomation(ctx context.Context, accountID, id string) (*models.EmailAutomation, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, errors.New("invalid automation id")
+	}
+	a, err := s.repo.GetAutomation(ctx, accountID, oid)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyTotals(ctx, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (s *automationServices) ListAutomations(ctx context.Context, accountID string, limit, offset int) ([]*models.EmailAutomation, int, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	return s.repo.ListAutomations(ctx, accountID, limit, offset)
+}
+
+// ActivateAutomation: علاوه بر اعتبار گراف، وجود حداقل یک Trigger هم لازم است
+func (s *automationServices) ActivateAutomation(ctx context.Context, accountID, id string) (*models.EmailAutomation, error) {
+	a, err := s.GetAutomation(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAutomation(a); err != nil {
+		return nil, err
+	}
+	if len(a.TriggerEvents) == 0 {
+		return nil, errors.New("automation needs at least one trigger event to be activated")
+	}
+	for _, t := range a.TriggerEvents {
+		if t.EventType == domain.TriggerEventBirthday || t.EventType == domain.TriggerEventAnniversary {
+			if toString(t.EventConditions[domain.TriggerConditionListID]) == "" && toString(t.EventConditions[domain.TriggerConditionGroupID]) == "" {
+				return nil, fmt.Errorf("%s trigger needs list_id or group_id", t.EventType)
+			}
+		}
+	}
+
+	if err := s.repo.SetActive(ctx, accountID, a.ID, true); err != nil {
+		return nil, err
+	}
+	a.IsActive = true
+	return a, nil
+}
+
+func (s *automationServices) DeactivateAutomation(ctx context.Context, accountID, id string) (*models.EmailAutomation, error) {
+	a, err := s.GetAutomation(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetActive(ctx, accountID, a.ID, false); err != nil {
+		return nil, err
+	}
+	a.IsActive = false
+	return a, nil
+}
+
+// DeleteAutomation: جریان‌های باقی‌مانده در اجرای بعدی موتور خارج (exited) می‌شوند
+func (s *automationServices) DeleteAutomation(ctx context.Context, accountID, id string) error {
+	a, err := s.GetAutomation(ctx, accountID, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteAutomation(ctx, accountID, a.ID); err != nil {
+		return err
+	}
+	return s.unlinkCampaigns(ctx, accountID, linkedCampaigns(a))
+}
+
+// RollupSplitStats آمار شاخه‌ها را محاسبه و روی مرحله ذخیره می‌کند (شاخه بدون ورودی آمار صفر دارد)
+func (s *automationServices) RollupSplitStats(ctx context.Context, accountID, automationID, stepID string) ([]models.StepStats, error) {
+	a, step, err := s.splitStep(ctx, accountID, automationID, stepID)
+	if err != nil {
+		return nil, err
+	}
+	byGroup, err := s.journeys.SplitBranchStats(ctx, a.ID, step.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]models.StepStats, len(step.SplitStepIDs))
+	for i := range stats {
+		stats[i] = byGroup[i]
+		stats[i].LastUpdatedAt = time.Now()
+	}
+	if err := s.repo.SaveSplitStats(ctx, a.ID, step.ID, stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// DeclareSplitWinner روی اتوماسیون فعال هم مجاز است؛ جریان‌هایی که قبلاً تقسیم شده‌اند در شاخه خود می‌مانند
+func (s *automationServices) DeclareSplitWinner(ctx context.Context, accountID, automationID, stepID string, branch int) (*models.EmailAutomation, error) {
+	a, step, err := s.splitStep(ctx, accountID, automationID, stepID)
+	if err != nil {
+		return nil, err
+	}
+	if branch >= len(step.SplitStepIDs) {
+		return nil, fmt.Errorf("split step has %d branches", len(step.SplitStepIDs))
+	}
+
+	var winner primitive.ObjectID
+	if branch >= 0 {
+		winner = step.SplitStepIDs[branch]
+	}
+	if err := s.repo.SetSplitWinner(ctx, a.ID, step.ID, winner); err != nil {
+		return nil, err
+	}
+	step.SplitWinnerStepID = winner
+	return a, nil
+}
+
+func (s *automationServices) splitStep(ctx context.Context, accountID, automationID, stepID string) (*models.EmailAutomation, *models.AutomationStep, error) {
+	a, err := s.GetAutomation(ctx, accountID, automationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	sid, err := primitive.ObjectIDFromHex(stepID)
+	if err != nil {
+		return nil, nil, errors.New("invalid step id")
+	}
+	step := a.FindStep(sid)
+	if step == nil || step.StepType != models.STEP_SPLIT {
+		return nil, nil, errors.New("split step not found")
+	}
+	return a, step, nil
+}
+
+// keepStepResults آمار و برنده تقسیم را برای مراحلی که در ویرایش باقی مانده‌اند حفظ و برای مراحل جدید پاک می‌کند
+func keepStepResults(existing, updated *models.EmailAutomation) {
+	for i := range updated.Steps {
+		st := &updated.Steps[i]
+		old := existing.FindStep(st.ID)
+		if old == nil {
+			st.Statistics, st.SplitStats, st.SplitWinnerStepID = models.StepStats{}, nil, primitive.NilObjectID
+			continue
+		}
+		st.Statistics = old.Statistics
+		if st.StepType != models.STEP_SPLIT || old.StepType != models.STEP_SPLIT {
+			st.SplitStats, st.SplitWinnerStepID = nil, primitive.NilObjectID
+			continue
+		}
+		if len(st.SplitStepIDs) == len(old.SplitStepIDs) {
+			st.SplitStats = old.SplitStats
+		} else {
+			st.SplitStats = nil
+		}
+		st.SplitWinnerStepID = primitive.NilObjectID
+		if st.SplitBranch(old.SplitWinnerStepID) >= 0 {
+			st.SplitWinnerStepID = old.SplitWinnerStepID
+		}
+	}
+}
+
+// linkCampaigns وجود کمپین‌ها را بررسی و UsedInAutomations را روشن می‌کند
+func (s *automationServices) linkCampaigns(ctx context.Context, accountID string, campaignIDs []string) error {
+	for _, id := range campaignIDs {
+		c, err := s.campaigns.GetByID(ctx, id, accountID)
+		if err != nil {
+			return fmt.Errorf("linked campaign %s not found: %w", id, err)
+		}
+		if c.UsedInAutomations {
+			continue
+		}
+		if err := s.campaigns.SetUsedInAutomations(ctx, id, accountID, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unlinkCampaigns برای کمپین‌هایی که دیگر در هیچ اتوماسیونی استفاده نمی‌شوند UsedInAutomations را خاموش می‌کند
+func (s *automationServices) unlinkCampaigns(ctx context.Context, accountID string, campaignIDs []string) error {
+	for _, id := range campaignIDs {
+		linked, err := s.repo.IsCampaignLinked(ctx, accountID, id)
+		if err != nil {
+			return err
+		}
+		if linked {
+			continue
+		}
+		if _, err := s.campaigns.GetByID(ctx, id, accountID); err != nil {
+			continue // کمپین حذف شده است
+		}
+		if err := s.campaigns.SetUsedInAutomations(ctx, id, accountID, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func linkedCampaigns(a *models.EmailAutomation) []string {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, st := range a.Steps {
+		if st.CampaignID != "" && !seen[st.CampaignID] {
+			seen[st.CampaignID] = true
+			ids = append(ids, st.CampaignID)
+		}
+	}
+	return ids
+}
+
+// missing عناصری از old که در current نیستند
+func missing(old, current []string) []string {
+	keep := make(map[string]bool, len(current))
+	for _, id := range current {
+		keep[id] = true
+	}
+	var out []string
+	for _, id := range old {
+		if !keep[id] {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// ---------------------------------------------------------
+// اعتبارسنجی گراف مراحل
+// ---------------------------------------------------------
+
+// validateAutomation مراحل را بررسی می‌کند: شناسه/شماره تکراری، ارجاع به مرحله ناموجود و مراحل غیرقابل دسترس
+func validateAutomation(a *models.EmailAutomation) error {
+	if strings.TrimSpace(a.Name) == "" {
+		return errors.New("automation name is required")
+	}
+	if a.AccountID == "" {
+		return errors.New("account id is required")
+	}
+	if len(a.Steps) == 0 {
+		return errors.New("automation needs at least one step")
+	}
+	if err := validateSendingSettings(a.Settings); err != nil {
+		return err
+	}
+
+	ids := make(map[primitive.ObjectID]bool, len(a.Steps))
+	numbers := make(map[int]bool, len(a.Steps))
+	for i := range a.Steps {
+		st := &a.Steps[i]
+		if st.ID.IsZero() {
+			st.ID = primitive.NewObjectID()
+		}
+		if ids[st.ID] {
+			return fmt.Errorf("duplicate step id %s", st.ID.Hex())
+		}
+		ids[st.ID] = true
+		if numbers[st.StepNumber] {
+			return fmt.Errorf("duplicate step number %d", st.StepNumber)
+		}
+		numbers[st.StepNumber] = true
+	}
+
+	var problems []string
+	for i := range a.Steps {
+		st := &a.Steps[i]
+		label := fmt.Sprintf("step %d (%s)", st.StepNumber, st.ID.Hex())
+
+		switch st.StepType {
+		case models.STEP_EMAIL:
+			if st.MessageID.IsZero() && st.CampaignID == "" {
+				problems = append(problems, label+": email step needs a message or campaign")
+			}
+		case models.STEP_DELAY:
+			if st.DelayTime <= 0 || !validDelayUnits[st.DelayUnit] {
+				problems = append(problems, label+": delay step needs a positive delay_time and a valid delay_unit")
+			}
+		case models.STEP_CONDITION:
+			if len(st.Conditions) == 0 {
+				problems = append(problems, label+": condition step has no conditions")
+			}
+		case models.STEP_SPLIT:
+			if len(st.SplitStepIDs) < 2 {
+				problems = append(problems, label+": split step needs at least two branches")
+			}
+			if len(st.SplitRatio) > 0 && len(st.SplitRatio) != len(st.SplitStepIDs) {
+				problems = append(problems, label+": split_ratio must have one entry per branch")
+			}
+		case models.STEP_EXIT:
+		default:
+			problems = append(problems, fmt.Sprintf("%s: unknown step type %q", label, st.StepType))
+		}
+
+		// ارجاع به مرحله ناموجود (Dangling)
+		refs := append([]primitive.ObjectID{st.PositiveStepID, st.NegativeStepID}, st.SplitStepIDs...)
+		for _, ref := range refs {
+			if ref.IsZero() {
+				continue
+			}
+			if !ids[ref] {
+				problems = append(problems, fmt.Sprintf("%s: references missing step %s", label, ref.Hex()))
+			} else if ref == st.ID {
+				problems = append(problems, label+": references itself")
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+
+	// مراحل غیرقابل دسترس از مرحله اول
+	reached := reachableSteps(a)
+	var unreachable []string
+	for _, st := range a.Steps {
+		if !reached[st.ID] {
+			unreachable = append(unreachable, fmt.Sprintf("%d", st.StepNumber))
+		}
+	}
+	if len(unreachable) > 0 {
+		sort.Strings(unreachable)
+		return fmt.Errorf("unreachable steps: %s", strings.Join(unreachable, ", "))
+	}
+	return nil
+}
+
+// reachableSteps پیمایش گراف با همان قواعد موتور اجرا (automationEngine.execute)
+func reachableSteps(a *models.EmailAutomation) map[primitive.ObjectID]bool {
+	reached := make(map[primitive.ObjectID]bool, len(a.Steps))
+	stack := []*models.AutomationStep{a.FirstStep()}
+	for len(stack) > 0 {
+		st := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if st == nil || reached[st.ID] {
+			continue
+		}
+		reached[st.ID] = true
+
+		switch st.StepType {
+		case models.STEP_EXIT:
+		case models.STEP_CONDITION:
+			for _, target := range []primitive.ObjectID{st.PositiveStepID, st.NegativeStepID} {
+				if target.IsZero() {
+					stack = append(stack, a.NextStep(st))
+				} else {
+					stack = append(stack, a.FindStep(target))
+				}
+			}
+		case models.STEP_SPLIT:
+			for _, target := range st.SplitStepIDs {
+				stack = append(stack, a.FindStep(target))
+			}
+		default:
+			stack = append(stack, a.NextStep(st))
+		}
+	}
+	return reached
+}
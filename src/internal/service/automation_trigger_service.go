@@ -0,0 +1,329 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// فرمت‌های قابل قبول برای Attribute های تاریخ مخاطب
+var contactDateLayouts = []string{"2006-01-02", time.RFC3339, "2006/01/02", "02/01/2006", "01-02"}
+
+// بازه نسبت دادن درآمد: سفارش به آخرین ایمیل اتوماسیون که در این مدت قبل از آن ارسال شده نسبت داده می‌شود
+const revenueAttributionWindow = 7 * 24 * time.Hour
+
+type automationTriggerService struct {
+	automations port.IAutomationRepository
+	journeys    port.IJourneyRepository
+	stats       port.IAutomationStatsRepository
+	engine      port.IAutomationEngine
+	audience    port.IAudienceClient
+}
+
+func NewAutomationTriggerService(automations port.IAutomationRepository, journeys port.IJourneyRepository,
+	stats port.IAutomationStatsRepository, engine port.IAutomationEngine, audience port.IAudienceClient) port.IAutomationTriggerService {
+	return &automationTriggerService{
+		automations: automations,
+		journeys:    journeys,
+		stats:       stats,
+		engine:      engine,
+		audience:    audience,
+	}
+}
+
+// IngestEvent: رویداد با TriggerEvents اتوماسیون‌های فعال اکانت مقایسه و مخاطب در موارد منطبق ثبت‌نام می‌شود
+func (s *automationTriggerService) IngestEvent(ctx context.Context, ev *domain.AutomationEvent) (*domain.TriggerResult, error) {
+	if ev.AccountID == "" || ev.EventType == "" {
+		return nil, errors.New("account_id and event_type are required")
+	}
+	ev.Email = strings.ToLower(strings.TrimSpace(ev.Email))
+	if ev.Email == "" {
+		return nil, errors.New("email is required")
+	}
+	var subscriberID primitive.ObjectID
+	if ev.SubscriberID != "" {
+		id, err := primitive.ObjectIDFromHex(ev.SubscriberID)
+		if err != nil {
+			return nil, errors.New("invalid subscriber id")
+		}
+		subscriberID = id
+	}
+
+	if ev.OccurredAt.IsZero() {
+		ev.OccurredAt = time.Now()
+	}
+
+	res := &domain.TriggerResult{}
+	attributed, err := s.attributeRevenue(ctx, ev)
+	if err != nil {
+		return nil, err
+	}
+	res.AttributedAutomation = attributed
+
+	automations, err := s.automations.ListActiveByTrigger(ctx, ev.AccountID, ev.EventType)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range automations {
+		trigger := matchTrigger(a, ev)
+		if trigger == nil {
+			continue
+		}
+		res.MatchedAutomations++
+
+		vars := make(map[string]interface{}, len(ev.Data)+1)
+		for k, v := range ev.Data {
+			vars[k] = v
+		}
+		vars["event_type"] = ev.EventType
+
+		enrolled, reason, err := s.enroll(ctx, a, subscriberID, ev.Email, vars, trigger.Delay, time.Time{})
+		if err != nil {
+			return res, err
+		}
+		if enrolled != nil {
+			res.EnrolledJourneys = append(res.EnrolledJourneys, enrolled.ID.Hex())
+		} else {
+			res.Skipped = append(res.Skipped, fmt.Sprintf("%s: %s", a.ID.Hex(), reason))
+		}
+	}
+	return res, nil
+}
+
+// attributeRevenue درآمد رویداد فروش را به آخرین ایمیل اتوماسیون ارسال شده به مشترک (Last Touch) نسبت می‌دهد
+func (s *automationTriggerService) attributeRevenue(ctx context.Context, ev *domain.AutomationEvent) (string, error) {
+	if s.stats == nil || (ev.EventType != domain.ConversionEventOrderCompleted && ev.EventType != domain.ConversionEventPurchase) {
+		return "", nil
+	}
+	revenue, ok := toFloat(ev.Data["revenue"])
+	if !ok || revenue <= 0 {
+		return "", nil
+	}
+
+	journeys, err := s.journeys.ListJourneysByEmail(ctx, ev.AccountID, ev.Email, ev.OccurredAt.Add(-revenueAttributionWindow))
+	if err != nil {
+		return "", err
+	}
+	var touch *models.JourneyStep
+	var touched *models.AutomationJourney
+	for _, j := range journeys {
+		for i := range j.StepHistory {
+			h := &j.StepHistory[i]
+			if h.StepType != models.STEP_EMAIL || h.Status != models.JOURNEY_STEP_COMPLETED || h.CompletedAt.After(ev.OccurredAt) ||
+				ev.OccurredAt.Sub(h.CompletedAt) > revenueAttributionWindow {
+				continue
+			}
+			if touch == nil || h.CompletedAt.After(touch.CompletedAt) {
+				touch, touched = h, j
+			}
+		}
+	}
+	if touch == nil {
+		return "", nil
+	}
+
+	// بدون order_id تکرار همان رویداد با زمان وقوع تشخیص داده می‌شود
+	orderID := toString(ev.Data["order_id"])
+	if orderID == "" {
+		orderID = ev.Email + "@" + ev.OccurredAt.UTC().Format(time.RFC3339Nano)
+	}
+	recorded, err := s.stats.RecordConversion(ctx, &domain.AutomationConversion{
+		AccountID:    ev.AccountID,
+		OrderID:      orderID,
+		AutomationID: touched.AutomationID.Hex(),
+		JourneyID:    touched.ID.Hex(),
+		StepID:       touch.StepID.Hex(),
+		Email:        ev.Email,
+		Revenue:      revenue,
+		OccurredAt:   ev.OccurredAt,
+	})
+	if err != nil || !recorded {
+		return "", err
+	}
+	return touched.AutomationID.Hex(), nil
+}
+
+// matchTrigger اولین TriggerEvent منطبق با نوع رویداد و شرایط آن
+func matchTrigger(a *models.EmailAutomation, ev *domain.AutomationEvent) *models.TriggerEvent {
+	for i := range a.TriggerEvents {
+		t := &a.TriggerEvents[i]
+		if t.EventType == ev.EventType && matchEventConditions(t.EventConditions, ev.Data) {
+			return t
+		}
+	}
+	return nil
+}
+
+// enroll قوانین ورود را اعمال می‌کند: OneTimeOnly، عدم ورود دوباره تا پایان جریان فعلی، و یکبار در روز برای Sweep
+func (s *automationTriggerService) enroll(ctx context.Context, a *models.EmailAutomation, subscriberID primitive.ObjectID, email string,
+	vars map[string]interface{}, delay time.Duration, since time.Time) (*models.AutomationJourney, string, error) {
+
+	if a.OneTimeOnly {
+		since = time.Time{}
+	}
+	if a.OneTimeOnly || !since.IsZero() {
+		exists, err := s.journeys.HasJourney(ctx, a.ID, email, since)
+		if err != nil {
+			return nil, "", err
+		}
+		if exists {
+			if a.OneTimeOnly {
+				return nil, "one_time_only", nil
+			}
+			return nil, "already_enrolled", nil
+		}
+	}
+
+	active, err := s.journeys.HasActiveJourney(ctx, a.ID, email)
+	if err != nil {
+		return nil, "", err
+	}
+	if active {
+		return nil, "already_in_journey", nil
+	}
+
+	j, err := s.engine.Enroll(ctx, a, subscriberID, email, vars, delay)
+	if err != nil {
+		return nil, "", err
+	}
+	return j, "", nil
+}
+
+// RunDateSweep: مخاطبانی که تاریخ تولد/سالگردشان امروز است وارد اتوماسیون مربوطه می‌شوند
+func (s *automationTriggerService) RunDateSweep(ctx context.Context, day time.Time) (int, error) {
+	automations, err := s.automations.ListActiveByType(ctx, models.AUTO_BIRTHDAY, models.AUTO_ANNIVERSARY)
+	if err != nil {
+		return 0, err
+	}
+
+	startOfDay := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	total := 0
+	for _, a := range automations {
+		for _, t := range a.TriggerEvents {
+			if t.EventType != domain.TriggerEventBirthday && t.EventType != domain.TriggerEventAnniversary {
+				continue
+			}
+			n, err := s.sweepTrigger(ctx, a, t, day, startOfDay)
+			total += n
+			if err != nil {
+				log.Printf("⚠️ automation %s date sweep: %v", a.ID.Hex(), err)
+			}
+		}
+	}
+	return total, nil
+}
+
+func (s *automationTriggerService) sweepTrigger(ctx context.Context, a *models.EmailAutomation, t models.TriggerEvent,
+	day, startOfDay time.Time) (int, error) {
+
+	listID := toString(t.EventConditions[domain.TriggerConditionListID])
+	groupID := toString(t.EventConditions[domain.TriggerConditionGroupID])
+	if listID == "" && groupID == "" {
+		return 0, errors.New("date trigger needs list_id or group_id in event_conditions")
+	}
+	field := toString(t.EventConditions[domain.TriggerConditionDateField])
+	if field == "" && t.EventType == domain.TriggerEventBirthday {
+		field = "birthday"
+	}
+
+	enrolled := 0
+	pageToken := ""
+	for {
+		members, next, err := s.audience.ListMembers(ctx, a.AccountID, listID, groupID, pageToken)
+		if err != nil {
+			return enrolled, err
+		}
+
+		for _, m := range members {
+			if m.Status != "" && !strings.EqualFold(m.Status, "subscribed") && !strings.EqualFold(m.Status, "active") {
+				continue
+			}
+			date, ok := memberDate(m, field)
+			if !ok || !sameAnniversary(date, day) {
+				continue
+			}
+
+			vars := map[string]interface{}{
+				"event_type": t.EventType,
+				"first_name": m.FirstName,
+				"last_name":  m.LastName,
+			}
+			for k, v := range m.Attributes {
+				vars[k] = v
+			}
+			if date.Year() > 1 {
+				vars["years"] = day.Year() - date.Year()
+			}
+
+			// تکرار Sweep در همان روز (مثلاً بعد از Restart) مخاطب را دوباره وارد نمی‌کند
+			j, _, err := s.enroll(ctx, a, primitive.NilObjectID, strings.ToLower(m.Email), vars, t.Delay, startOfDay)
+			if err != nil {
+				return enrolled, err
+			}
+			if j != nil {
+				enrolled++
+			}
+		}
+
+		if next == "" {
+			return enrolled, nil
+		}
+		pageToken = next
+	}
+}
+
+func (s *automationTriggerService) RunDailySweep(ctx context.Context) error {
+	for {
+		now := time.Now().UTC()
+		if n, err := s.RunDateSweep(ctx, now); err != nil {
+			log.Printf("⚠️ automation date sweep failed: %v", err)
+		} else {
+			log.Printf("🎂 automation date sweep enrolled %d contacts", n)
+		}
+
+		nextRun := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 5, 0, 0, time.UTC)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Until(nextRun)):
+		}
+	}
+}
+
+// memberDate تاریخ مخاطب از Attribute؛ برای سالگرد بدون Attribute از تاریخ عضویت استفاده می‌شود
+func memberDate(m domain.AudienceMember, field string) (time.Time, bool) {
+	if field == "" {
+		return m.JoinedAt, !m.JoinedAt.IsZero()
+	}
+	raw := strings.TrimSpace(m.Attributes[field])
+	if raw == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range contactDateLayouts {
+		if t, err := time.Parse(layout, raw); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// sameAnniversary مقایسه ماه و روز؛ متولدین ۲۹ فوریه در سال‌های غیرکبیسه ۲۸ فوریه حساب می‌شوند
+func sameAnniversary(date, day time.Time) bool {
+	month, d := date.Month(), date.Day()
+	if month == time.February && d == 29 && !isLeapYear(day.Year()) {
+		d = 28
+	}
+	return month == day.Month() && d == day.Day()
+}
+
+func isLeapYear(y int) bool {
+	return y%4 == 0 && (y%100 != 0 || y%400 == 0)
+}
@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
@@ -11,12 +13,21 @@ import (
 )
 
 type CampaignService struct {
-	repo port.ICampaignRepository
+	repo      port.ICampaignRepository
+	templates port.ITemplateRepository // اختیاری؛ برای بررسی Lint قالب‌های کمپین
+	linter    port.ITemplateLinter     // اختیاری؛ برای نسخه‌هایی که هنوز Lint نشده‌اند
+	blocks    port.IBlockExpander      // اختیاری؛ Lint روی محتوای نهایی با بلاک‌های باز شده انجام می‌شود
+	events    port.IEventsClient       // اختیاری؛ منبع گزارش‌های عملکرد کمپین
 }
 
-func NewCampaignServiceMta(repo port.ICampaignRepository) port.ICampaignService {
+func NewCampaignServiceMta(repo port.ICampaignRepository, templates port.ITemplateRepository, linter port.ITemplateLinter,
+	blocks port.IBlockExpander, events port.IEventsClient) port.ICampaignService {
 	return &CampaignService{
-		repo: repo,
+		repo:      repo,
+		templates: templates,
+		linter:    linter,
+		blocks:    blocks,
+		events:    events,
 	}
 }
 
@@ -98,6 +109,17 @@ func (s *CampaignService) ScheduleCampaign(ctx context.Context, id string, accou
 	if len(campaign.EmailIDs) == 0 {
 		return nil, errors.New("campaign must have content linked to it")
 	}
+	if err := campaign.Options.ValidateDelivery(); err != nil {
+		return nil, err
+	}
+
+	// ۳.۱ بررسی کیفیت قالب‌ها (خطاهای Lint مانع زمان‌بندی می‌شوند)
+	report := &domain.CampaignPreflight{}
+	s.checkContent(ctx, campaign, report)
+	if len(report.Errors) > 0 {
+		return nil, fmt.Errorf("campaign content failed pre-flight check: %s", strings.Join(report.Errors, "; "))
+	}
+	campaign.Warnings = mergeWarnings(campaign.Warnings, report.Warnings)
 
 	// ۴. اعمال تغییرات
 	campaign.ScheduledFor = &sendAt
@@ -142,3 +164,89 @@ func (s *CampaignService) DeleteCampaign(ctx context.Context, id string, account
 	// معمولاً Soft Delete پیشنهاد می‌شود، اما طبق متد Repository فعلاً Hard Delete می‌کنیم
 	return s.repo.Delete(ctx, id, accountID)
 }
+
+// PreflightCampaign: همان بررسی‌های ScheduleCampaign بدون تغییر وضعیت کمپین
+func (s *CampaignService) PreflightCampaign(ctx context.Context, id string, accountID string) (*domain.CampaignPreflight, error) {
+	campaign, err := s.repo.GetByID(ctx, id, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.CampaignPreflight{}
+	if len(campaign.Recipients.ListIDs) == 0 && len(campaign.Recipients.SegmentIDs) == 0 {
+		report.Errors = append(report.Errors, "campaign must have at least one recipient list or segment")
+	}
+	if len(campaign.EmailIDs) == 0 {
+		report.Errors = append(report.Errors, "campaign must have content linked to it")
+	}
+	if err := campaign.Options.ValidateDelivery(); err != nil {
+		report.Errors = append(report.Errors, err.Error())
+	}
+	s.checkContent(ctx, campaign, report)
+
+	report.Ready = len(report.Errors) == 0
+	return report, nil
+}
+
+// mergeWarnings هشدارهای جدید بدون تکرار به هشدارهای قبلی کمپین اضافه می‌شوند
+func mergeWarnings(existing, added []string) []string {
+	seen := make(map[string]bool, len(existing))
+	for _, w := range existing {
+		seen[w] = true
+	}
+	for _, w := range added {
+		if !seen[w] {
+			seen[w] = true
+			existing = append(existing, w)
+		}
+	}
+	return existing
+}
+
+// checkContent نتیجه Lint نسخه فعلی هر قالب کمپین را به گزارش اضافه می‌کند
+// اگر نسخه هنوز Lint نشده باشد (و linter تنظیم شده باشد) همین‌جا Lint و ذخیره می‌شود
+func (s *CampaignService) checkContent(ctx context.Context, c *domain.Campaign, report *domain.CampaignPreflight) {
+	if s.templates == nil {
+		return
+	}
+	if report.Lint == nil {
+		report.Lint = make(map[string]*domain.TemplateLintResult)
+	}
+
+	for _, templateID := range c.EmailIDs {
+		t, v, err := s.templates.GetTemplate(ctx, c.AccountID, templateID)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("template %s not found", templateID))
+			continue
+		}
+		// فقط نسخه تایید شده می‌تواند به کمپین زمان‌بندی شده متصل شود
+		if t.CurrentVersionID == "" || !v.IsApproved() {
+			report.Errors = append(report.Errors, fmt.Sprintf("template %s has no approved version", templateID))
+			continue
+		}
+
+		result := v.LintResult
+		if result == nil && s.linter != nil {
+			expanded, err := expandVersionBlocks(ctx, s.blocks, c.AccountID, v)
+			if err != nil {
+				report.Errors = append(report.Errors, fmt.Sprintf("template %s: %v", templateID, err))
+				continue
+			}
+			result = s.linter.Lint(ctx, expanded)
+			_ = s.templates.SaveLintResult(ctx, v.ID, result)
+		}
+		if result == nil {
+			continue
+		}
+
+		report.Lint[templateID] = result
+		for _, f := range result.Findings {
+			msg := fmt.Sprintf("template %s: %s", templateID, f.Message)
+			if f.Severity == domain.LintSeverityError {
+				report.Errors = append(report.Errors, msg)
+			} else {
+				report.Warnings = append(report.Warnings, msg)
+			}
+		}
+	}
+}
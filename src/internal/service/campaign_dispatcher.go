@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// recipientListVar لیست مبدأ گیرنده در RecipientData؛ لغو عضویت برای همین لیست ثبت می‌شود
+const recipientListVar = "list_id"
+
+const (
+	dispatchClaimLimit  = 10   // کمپین‌های برداشته شده در هر اجرا
+	dispatchChunkSize   = 5000 // آیتم‌های هر دسته صف
+	dispatchFinishLimit = 100
+)
+
+// recipientScheduler زمان ارسال هر گیرنده در حالت‌های DeliveryOptimization؛ ok=false یعنی گیرنده ارسال نمی‌شود
+type recipientScheduler interface {
+	at(m domain.AudienceMember) (time.Time, bool)
+	// summary توزیع زمان‌های انتخاب شده برای WinnerSendingTimeForHumans
+	summary() string
+}
+
+type campaignDispatcher struct {
+	campaigns port.ICampaignRepository
+	templates port.ITemplateServices // محتوای کمپین هنگام dispatch فریز می‌شود
+	audience  port.IAudienceClient
+	queue     port.ISendQueue
+	sendTimes *sendTimeOptimizer // nil اگر سرویس رویدادها تنظیم نشده باشد
+}
+
+// events اختیاری است؛ بدون آن حالت optimal_time همه گیرندگان را در زمان شروع ارسال می‌کند
+func NewCampaignDispatcher(campaigns port.ICampaignRepository, templates port.ITemplateServices, audience port.IAudienceClient,
+	queue port.ISendQueue, events port.IEventsClient) port.ICampaignDispatcher {
+	d := &campaignDispatcher{campaigns: campaigns, templates: templates, audience: audience, queue: queue}
+	if events != nil {
+		d.sendTimes = newSendTimeOptimizer(events)
+	}
+	return d
+}
+
+func (d *campaignDispatcher) DispatchDue(ctx context.Context, now time.Time) (int, error) {
+	if err := d.finishSent(ctx, now); err != nil {
+		log.Printf("⚠️ campaign finish check failed: %v", err)
+	}
+
+	claimed, err := d.campaigns.ClaimDue(ctx, now, dispatchClaimLimit)
+	if err != nil {
+		return 0, err
+	}
+	dispatched := 0
+	for _, c := range claimed {
+		if err := d.dispatch(ctx, c, now); err != nil {
+			log.Printf("⚠️ campaign %s dispatch failed: %v", c.ID, err)
+			d.fail(ctx, c, err, now)
+			continue
+		}
+		dispatched++
+	}
+	return dispatched, nil
+}
+
+func (d *campaignDispatcher) Run(ctx context.Context, every time.Duration) error {
+	for {
+		if _, err := d.DispatchDue(ctx, time.Now()); err != nil {
+			log.Printf("⚠️ campaign dispatch failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(every):
+		}
+	}
+}
+
+// dispatch گیرندگان کمپین را در دسته‌های dispatchChunkSize تایی به صف می‌فرستد
+//
+// اگر بین دسته‌ها خطایی رخ دهد کمپین Failed می‌شود و آیتم‌هایی که قبلاً در صف قرار گرفته‌اند هم ارسال نمی‌شوند
+// (campaignSender آیتم‌های کمپین Failed را با خطای دائمی کنار می‌گذارد).
+func (d *campaignDispatcher) dispatch(ctx context.Context, c *domain.Campaign, now time.Time) error {
+	// آیتم‌های صف از این اسنپ‌شات رندر می‌شوند، نه از نسخه فعلی قالب
+	templateID := campaignTemplateID(c)
+	if templateID == "" {
+		return errors.New("campaign has no content")
+	}
+	if _, err := d.templates.SnapshotTemplate(ctx, c.AccountID, templateID, c.ID); err != nil {
+		return fmt.Errorf("snapshot campaign content: %w", err)
+	}
+
+	var plan recipientScheduler
+	switch strings.ToLower(c.Options.DeliveryOptimization) {
+	case domain.DeliveryOptimalTime:
+		if d.sendTimes != nil {
+			p, err := d.sendTimes.plan(ctx, c.AccountID, now)
+			if err != nil {
+				return err
+			}
+			plan = p
+		}
+	case domain.DeliveryLocalTime:
+		plan = newLocalTimePlan(c, now)
+	}
+
+	total := 0
+	items := make([]*models.QueueItem, 0, dispatchChunkSize)
+	flush := func() error {
+		if len(items) == 0 {
+			return nil
+		}
+		if _, err := d.queue.Enqueue(ctx, c.AccountID, primitive.NilObjectID, items); err != nil {
+			return err
+		}
+		total += len(items)
+		items = make([]*models.QueueItem, 0, dispatchChunkSize)
+		return nil
+	}
+
+	err := d.eachRecipient(ctx, c, func(m domain.AudienceMember, listID string) error {
+		item := &models.QueueItem{
+			CampaignRef:    c.ID,
+			RecipientEmail: m.Email,
+			RecipientData:  recipientData(m, listID),
+		}
+		if plan != nil {
+			at, ok := plan.at(m)
+			if !ok {
+				return nil
+			}
+			item.ScheduledAt = at
+		}
+		if items = append(items, item); len(items) >= dispatchChunkSize {
+			return flush()
+		}
+		return nil
+	})
+	if err == nil {
+		err = flush()
+	}
+	if err != nil {
+		return fmt.Errorf("enqueued %d recipients before error: %w", total, err)
+	}
+	if total == 0 {
+		return errors.New("campaign has no recipients to send to")
+	}
+
+	if plan != nil {
+		c.WinnerSendingTimeForHumans = plan.summary()
+	}
+	c.IsCurrentlySending = true
+	return d.campaigns.MarkSending(ctx, c.ID, c.AccountID, c.WinnerSendingTimeForHumans)
+}
+
+// eachRecipient اعضای فعال لیست‌ها و سگمنت‌ها (گروه‌های Audience) کمپین؛ هر ایمیل یک بار
+// listID لیستی است که گیرنده اولین بار در آن دیده شد (خالی برای سگمنت‌ها)
+func (d *campaignDispatcher) eachRecipient(ctx context.Context, c *domain.Campaign, fn func(m domain.AudienceMember, listID string) error) error {
+	type source struct{ listID, groupID string }
+	var sources []source
+	for _, id := range c.Recipients.ListIDs {
+		sources = append(sources, source{listID: id})
+	}
+	for _, id := range c.Recipients.SegmentIDs {
+		sources = append(sources, source{groupID: id})
+	}
+
+	seen := make(map[string]struct{})
+	for _, src := range sources {
+		pageToken := ""
+		for {
+			members, next, err := d.audience.ListMembers(ctx, c.AccountID, src.listID, src.groupID, pageToken)
+			if err != nil {
+				return err
+			}
+			for _, m := range members {
+				if m.Status != "" && !strings.EqualFold(m.Status, "subscribed") && !strings.EqualFold(m.Status, "active") {
+					continue
+				}
+				m.Email = strings.ToLower(strings.TrimSpace(m.Email))
+				if _, ok := seen[m.Email]; ok || m.Email == "" {
+					continue
+				}
+				seen[m.Email] = struct{}{}
+				if err := fn(m, src.listID); err != nil {
+					return err
+				}
+			}
+			if next == "" {
+				break
+			}
+			pageToken = next
+		}
+	}
+	return nil
+}
+
+// finishSent کمپین‌هایی که همه آیتم‌هایشان ارسال (یا نهایتاً رد) شده‌اند Sent می‌شوند
+func (d *campaignDispatcher) finishSent(ctx context.Context, now time.Time) error {
+	processing, err := d.campaigns.ListByStatus(ctx, domain.StatusProcessing, dispatchFinishLimit)
+	if err != nil {
+		return err
+	}
+	for _, c := range processing {
+		// کمپینی که هنوز در حال ساخت آیتم‌های صف است
+		if !c.IsCurrentlySending {
+			continue
+		}
+		pending, err := d.queue.PendingItems(ctx, c.AccountID, c.ID)
+		if err != nil {
+			return err
+		}
+		if pending > 0 {
+			continue
+		}
+		if err := d.campaigns.FinishDispatch(ctx, c.ID, c.AccountID, domain.StatusSent, now, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fail فقط وضعیت، زمان پایان و هشدار ذخیره می‌شود تا شمارنده‌های Stats که ApplyEvents نوشته بازنویسی نشوند
+func (d *campaignDispatcher) fail(ctx context.Context, c *domain.Campaign, cause error, now time.Time) {
+	if err := d.campaigns.FinishDispatch(ctx, c.ID, c.AccountID, domain.StatusFailed, now, "dispatch failed: "+cause.Error()); err != nil {
+		log.Printf("⚠️ campaign %s: failed to save dispatch failure: %v", c.ID, err)
+	}
+}
+
+// recipientData متغیرهای شخصی‌سازی گیرنده برای رندر قالب
+func recipientData(m domain.AudienceMember, listID string) map[string]interface{} {
+	data := make(map[string]interface{}, len(m.Attributes)+4)
+	for k, v := range m.Attributes {
+		data[k] = v
+	}
+	data["email"] = m.Email
+	data["first_name"] = m.FirstName
+	data["last_name"] = m.LastName
+	if listID != "" {
+		data[recipientListVar] = listID
+	}
+	return data
+}
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+)
+
+const (
+	defaultDomainStatsTopN  = 10
+	maxDomainStatsTopN      = 100
+	defaultDomainStatsRange = 30 * 24 * time.Hour
+	otherDomainsBucket      = "other"
+	maxDomainStatsEvents    = 100000 // رویدادهای خوانده شده از هر نوع؛ بیشتر از آن نمونه‌برداری می‌شود
+)
+
+// domainStatEvents رویدادهایی که در آمار دامنه شمرده می‌شوند
+var domainStatEvents = []string{
+	domain.EventSent, domain.EventDelivered, domain.EventBounced,
+	domain.EventOpened, domain.EventClicked, domain.EventComplained,
+}
+
+// domainCounters تعداد گیرندگان یکتا به ازای هر نوع رویداد
+type domainCounters map[string]int
+
+// GetCampaignDomainStats رویدادهای کمپین را بر اساس دامنه ISP گیرنده (مثلاً googlemail.com ← gmail.com) جمع می‌زند
+//
+// هر گیرنده در هر کمپین برای هر نوع رویداد یک بار شمرده می‌شود (باز کردن‌های تکراری نرخ را بالا نمی‌برند).
+// نرخ تحویل و Bounce نسبت به ارسال و نرخ‌های تعامل نسبت به تحویل محاسبه می‌شوند.
+// اگر رویدادهای یک نوع از maxDomainStatsEvents بیشتر باشند از آن‌ها نمونه‌برداری و شمارنده‌ها به کل مقیاس می‌شوند (Sampled).
+func (s *CampaignService) GetCampaignDomainStats(ctx context.Context, id string, accountID string, from, to time.Time, topN int) (*domain.CampaignDomainStats, error) {
+	if s.events == nil {
+		return nil, errors.New("campaign reports are not configured")
+	}
+	if id != "" {
+		if _, err := s.repo.GetByID(ctx, id, accountID); err != nil {
+			return nil, err
+		}
+	}
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-defaultDomainStatsRange)
+	}
+	if !from.Before(to) {
+		return nil, errors.New("from must be before to")
+	}
+	if topN <= 0 {
+		topN = defaultDomainStatsTopN
+	}
+	topN = min(topN, maxDomainStatsTopN)
+
+	byDomain := make(map[string]domainCounters)
+	seen := make(map[string]struct{})
+	sampled := false
+	for _, eventType := range domainStatEvents {
+		filter := domain.EventFilter{CampaignID: id, Type: eventType, From: from, To: to}
+		scale, err := sampleEvents(ctx, s.events, accountID, filter, maxDomainStatsEvents, func(e domain.EmailEvent) {
+			d := ispDomain(emailDomain(e.Email))
+			if d == "" {
+				return
+			}
+			key := eventType + "\x00" + e.CampaignID + "\x00" + strings.ToLower(e.Email)
+			if _, ok := seen[key]; ok {
+				return
+			}
+			seen[key] = struct{}{}
+			if byDomain[d] == nil {
+				byDomain[d] = make(domainCounters)
+			}
+			byDomain[d][eventType]++
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list %s events: %w", eventType, err)
+		}
+		if scale > 1 {
+			sampled = true
+			for _, c := range byDomain {
+				c[eventType] = int(math.Round(float64(c[eventType]) * scale))
+			}
+		}
+		clear(seen)
+	}
+
+	result := &domain.CampaignDomainStats{CampaignID: id, From: from, To: to, Sampled: sampled}
+	total := make(domainCounters)
+	for d, c := range byDomain {
+		result.Domains = append(result.Domains, domainStat(d, c))
+		for t, n := range c {
+			total[t] += n
+		}
+	}
+	sort.Slice(result.Domains, func(i, j int) bool {
+		a, b := result.Domains[i], result.Domains[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Domain < b.Domain
+	})
+	result.Total = domainStat("", total)
+
+	if len(result.Domains) > topN {
+		// ردیف other از جمع شمارنده‌ها ساخته می‌شود تا نرخ‌ها وزن‌دار باشند
+		other := make(domainCounters)
+		for _, st := range result.Domains[topN:] {
+			for t, n := range byDomain[st.Domain] {
+				other[t] += n
+			}
+		}
+		result.Domains = append(result.Domains[:topN], domainStat(otherDomainsBucket, other))
+	}
+	return result, nil
+}
+
+// domainStat؛ اگر رویداد sent ثبت نشده باشد تعداد ارسال از جمع تحویل و Bounce به دست می‌آید
+func domainStat(name string, c domainCounters) models.DomainStat {
+	sent := max(c[domain.EventSent], c[domain.EventDelivered]+c[domain.EventBounced])
+	delivered := c[domain.EventDelivered]
+	return models.DomainStat{
+		Domain:         name,
+		Count:          sent,
+		DeliveryRate:   ratio(delivered, sent),
+		OpenRate:       ratio(c[domain.EventOpened], delivered),
+		ClickRate:      ratio(c[domain.EventClicked], delivered),
+		BounceRate:     ratio(c[domain.EventBounced], sent),
+		SpamReportRate: ratio(c[domain.EventComplained], delivered),
+	}
+}
+
+// sampleEvents اگر رویدادهای filter بیشتر از maxEvents باشند فقط صفحه‌هایی با فاصله یکسان در کل بازه خوانده می‌شوند؛
+// نسبت تعداد کل به تعداد خوانده شده (ضریب مقیاس شمارنده‌ها) برمی‌گردد
+func sampleEvents(ctx context.Context, events port.IEventsClient, accountID string, filter domain.EventFilter, maxEvents int,
+	fn func(e domain.EmailEvent)) (float64, error) {
+	list, total, err := events.ListEvents(ctx, accountID, filter, 1)
+	if err != nil {
+		return 0, err
+	}
+	for _, e := range list {
+		fn(e)
+	}
+	read, pageSize := len(list), len(list)
+	if pageSize == 0 || read >= total {
+		return 1, nil
+	}
+
+	pages := (total + pageSize - 1) / pageSize
+	step := max(1, (pages+maxEvents/pageSize-1)/max(1, maxEvents/pageSize))
+	for page := 1 + step; page <= pages; page += step {
+		list, _, err := events.ListEvents(ctx, accountID, filter, page)
+		if err != nil {
+			return 0, err
+		}
+		if len(list) == 0 {
+			break
+		}
+		for _, e := range list {
+			fn(e)
+		}
+		read += len(list)
+	}
+	return float64(total) / float64(read), nil
+}
+
+// forEachEvent همه صفحه‌های رویدادهای منطبق با filter را می‌خواند
+func forEachEvent(ctx context.Context, events port.IEventsClient, accountID string, filter domain.EventFilter, fn func(e domain.EmailEvent)) error {
+	for page, read := 1, 0; ; page++ {
+		list, total, err := events.ListEvents(ctx, accountID, filter, page)
+		if err != nil {
+			return err
+		}
+		for _, e := range list {
+			fn(e)
+		}
+		if read += len(list); len(list) == 0 || read >= total {
+			return nil
+		}
+	}
+}
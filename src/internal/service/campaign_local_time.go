@@ -0,0 +1,110 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+)
+
+// localTimeGrace گیرندگانی که ساعت ارسالشان کمتر از این مدت گذشته است بدون اعمال سیاست فوراً ارسال می‌شوند
+const localTimeGrace = 15 * time.Minute
+
+// localTimePlan ارسال در ساعت دیواری ScheduledFor (به وقت منطقه زمانی کمپین) به وقت محلی هر گیرنده
+//
+// منطقه زمانی از Attribute timezone مخاطب خوانده می‌شود؛ گیرنده بدون منطقه زمانی معتبر در زمان شروع ارسال می‌شود.
+// کمپین از Campaign.DispatchAt (وقتی ساعت در UTC+14 فرا می‌رسد) برداشته می‌شود و آیتم‌های آینده Pending می‌مانند،
+// پس کمپین تا ارسال آخرین منطقه زمانی Processing است.
+type localTimePlan struct {
+	wall   time.Time // ScheduledFor به وقت منطقه زمانی کمپین؛ فقط تاریخ و ساعت آن استفاده می‌شود
+	now    time.Time
+	policy string
+
+	zones                  map[string]*time.Location // nil یعنی منطقه زمانی نامعتبر
+	used                   map[string]bool
+	last                   time.Time
+	local, noZone, skipped int
+}
+
+func newLocalTimePlan(c *domain.Campaign, now time.Time) *localTimePlan {
+	wall := now
+	if c.ScheduledFor != nil {
+		wall = *c.ScheduledFor
+	}
+	policy := strings.ToLower(c.Options.LocalTimePolicy)
+	if policy == "" {
+		policy = domain.LocalTimeNextDay
+	}
+	return &localTimePlan{
+		wall:   wall.In(c.Options.Location()),
+		now:    now,
+		policy: policy,
+		zones:  make(map[string]*time.Location),
+		used:   make(map[string]bool),
+	}
+}
+
+func (p *localTimePlan) at(m domain.AudienceMember) (time.Time, bool) {
+	loc := p.location(strings.TrimSpace(m.Attributes[journeyTimezoneVar]))
+	if loc == nil {
+		p.noZone++
+		return p.now, true
+	}
+
+	w := p.wall
+	t := time.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), 0, loc)
+	if t.Before(p.now.Add(-localTimeGrace)) {
+		switch p.policy {
+		case domain.LocalTimeSkip:
+			p.skipped++
+			return time.Time{}, false
+		case domain.LocalTimeImmediate:
+			t = p.now
+		default:
+			t = time.Date(w.Year(), w.Month(), w.Day()+1, w.Hour(), w.Minute(), w.Second(), 0, loc)
+		}
+	}
+	t = maxTime(t, p.now)
+
+	p.local++
+	p.used[loc.String()] = true
+	if t.After(p.last) {
+		p.last = t
+	}
+	return t, true
+}
+
+// summary مثلاً: Local 09:00 in 12 timezones until 2026-10-20 19:00 UTC; 40 without timezone, 5 skipped
+func (p *localTimePlan) summary() string {
+	if p.local == 0 {
+		return fmt.Sprintf("Local %s: no recipient timezones; %d sent at start", p.wall.Format("15:04"), p.noZone)
+	}
+	s := fmt.Sprintf("Local %s in %d timezones until %s", p.wall.Format("15:04"), len(p.used), p.last.UTC().Format("2006-01-02 15:04 UTC"))
+	if p.noZone > 0 {
+		s += fmt.Sprintf("; %d without timezone", p.noZone)
+	}
+	if p.skipped > 0 {
+		s += fmt.Sprintf("; %d skipped", p.skipped)
+	}
+	return s
+}
+
+func (p *localTimePlan) location(tz string) *time.Location {
+	if tz == "" {
+		return nil
+	}
+	loc, ok := p.zones[tz]
+	if !ok {
+		loc, _ = time.LoadLocation(tz)
+		p.zones[tz] = loc
+	}
+	return loc
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
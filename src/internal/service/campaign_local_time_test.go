@@ -0,0 +1,120 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+)
+
+func TestCampaignDispatchAt(t *testing.T) {
+	at := func(s string) *time.Time {
+		v, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &v
+	}
+
+	tests := []struct {
+		name string
+		c    domain.Campaign
+		want string
+	}{
+		{
+			name: "regular campaign is claimed at scheduled_for",
+			c:    domain.Campaign{ScheduledFor: at("2026-10-20T09:00:00Z")},
+			want: "2026-10-20T09:00:00Z",
+		},
+		{
+			name: "local time in UTC is claimed 14 hours early",
+			c: domain.Campaign{ScheduledFor: at("2026-10-20T09:00:00Z"),
+				Options: domain.CampaignOptions{DeliveryOptimization: domain.DeliveryLocalTime}},
+			want: "2026-10-19T19:00:00Z",
+		},
+		{
+			name: "local time wall clock is read in the campaign timezone",
+			c: domain.Campaign{ScheduledFor: at("2026-10-20T09:00:00+03:30"),
+				Options: domain.CampaignOptions{DeliveryOptimization: domain.DeliveryLocalTime, Timezone: "Asia/Tehran"}},
+			want: "2026-10-19T19:00:00Z",
+		},
+		{
+			name: "campaign west of UTC is claimed more than 14 hours early",
+			c: domain.Campaign{ScheduledFor: at("2026-10-20T09:00:00-07:00"),
+				Options: domain.CampaignOptions{DeliveryOptimization: domain.DeliveryLocalTime, Timezone: "America/Los_Angeles"}},
+			want: "2026-10-19T19:00:00Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.c.DispatchAt()
+			if got == nil || !got.Equal(*at(tt.want)) {
+				t.Errorf("DispatchAt() = %v, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if (&domain.Campaign{}).DispatchAt() != nil {
+		t.Error("unscheduled campaign has a dispatch time")
+	}
+}
+
+func TestLocalTimePlan(t *testing.T) {
+	scheduled := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+	c := &domain.Campaign{
+		ScheduledFor: &scheduled,
+		Options:      domain.CampaignOptions{DeliveryOptimization: domain.DeliveryLocalTime},
+	}
+	member := func(tz string) domain.AudienceMember {
+		return domain.AudienceMember{Email: "a@example.com", Attributes: map[string]string{journeyTimezoneVar: tz}}
+	}
+
+	// برداشته شده در DispatchAt: ساعت ۹ هنوز در هیچ منطقه زمانی نرسیده است
+	now := *c.DispatchAt()
+	tests := []struct {
+		tz   string
+		want time.Time
+	}{
+		{tz: "Pacific/Kiritimati", want: now}, // UTC+14
+		{tz: "Asia/Tehran", want: time.Date(2026, 10, 20, 5, 30, 0, 0, time.UTC)},
+		{tz: "UTC", want: scheduled},
+		{tz: "America/New_York", want: time.Date(2026, 10, 20, 13, 0, 0, 0, time.UTC)},
+		{tz: "", want: now},             // بدون منطقه زمانی: زمان شروع
+		{tz: "Mars/Olympus", want: now}, // نامعتبر
+	}
+	p := newLocalTimePlan(c, now)
+	for _, tt := range tests {
+		got, ok := p.at(member(tt.tz))
+		if !ok || !got.Equal(tt.want) {
+			t.Errorf("at(%q) = %v, %v; want %v", tt.tz, got, ok, tt.want)
+		}
+	}
+	if p.local != 4 || p.noZone != 2 {
+		t.Errorf("local = %d, noZone = %d; want 4, 2", p.local, p.noZone)
+	}
+}
+
+func TestLocalTimePlanMissedZones(t *testing.T) {
+	scheduled := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+	// dispatcher دیر اجرا شده: ساعت ۹ در تهران (۰۵:۳۰ UTC) گذشته است
+	now := time.Date(2026, 10, 20, 7, 0, 0, 0, time.UTC)
+	tehran := domain.AudienceMember{Email: "a@example.com", Attributes: map[string]string{journeyTimezoneVar: "Asia/Tehran"}}
+
+	tests := []struct {
+		policy string
+		want   time.Time
+		ok     bool
+	}{
+		{policy: "", want: time.Date(2026, 10, 21, 5, 30, 0, 0, time.UTC), ok: true},
+		{policy: domain.LocalTimeImmediate, want: now, ok: true},
+		{policy: domain.LocalTimeSkip, ok: false},
+	}
+	for _, tt := range tests {
+		c := &domain.Campaign{ScheduledFor: &scheduled, Options: domain.CampaignOptions{
+			DeliveryOptimization: domain.DeliveryLocalTime, LocalTimePolicy: tt.policy}}
+		got, ok := newLocalTimePlan(c, now).at(tehran)
+		if ok != tt.ok || !got.Equal(tt.want) {
+			t.Errorf("policy %q: at() = %v, %v; want %v, %v", tt.policy, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+)
+
+const (
+	sendTimeHistory  = 90 * 24 * time.Hour // سابقه باز کردن‌هایی که در پروفایل شمرده می‌شوند
+	sendTimeCacheTTL = 6 * time.Hour
+)
+
+// openProfile تعداد باز کردن‌های هر گیرنده به تفکیک ساعت روز (UTC)
+type openProfile struct {
+	loadedAt   time.Time
+	recipients map[string]*[24]int32
+	account    [24]int32 // جمع همه گیرندگان اکانت
+}
+
+// sendTimeOptimizer پروفایل باز کردن‌های هر اکانت را یک بار از سرویس رویدادها می‌خواند و نگه می‌دارد
+type sendTimeOptimizer struct {
+	events port.IEventsClient
+
+	mu       sync.Mutex // فقط برای خواندن و نوشتن کش؛ در حین خواندن رویدادها نگه داشته نمی‌شود
+	profiles map[string]*openProfile
+	loading  map[string]*profileLoad // بارگذاری‌های در حال اجرا به ازای هر اکانت
+}
+
+// profileLoad بارگذاری در حال اجرای پروفایل یک اکانت؛ درخواست‌های همزمان همان اکانت منتظر done می‌مانند
+type profileLoad struct {
+	done    chan struct{}
+	profile *openProfile
+	err     error
+}
+
+func newSendTimeOptimizer(events port.IEventsClient) *sendTimeOptimizer {
+	return &sendTimeOptimizer{
+		events:   events,
+		profiles: make(map[string]*openProfile),
+		loading:  make(map[string]*profileLoad),
+	}
+}
+
+// plan زمان‌بندی ارسال در بازه [start, start+24h)
+func (o *sendTimeOptimizer) plan(ctx context.Context, accountID string, start time.Time) (*sendTimePlan, error) {
+	p, err := o.profile(ctx, accountID, start)
+	if err != nil {
+		return nil, err
+	}
+	return &sendTimePlan{profile: p, start: start, fallbackHour: bestHour(&p.account)}, nil
+}
+
+// profile پروفایل کش شده را برمی‌گرداند یا آن را بیرون از قفل بارگذاری می‌کند تا کندی سرویس رویدادها
+// برای یک اکانت، ارسال اکانت‌های دیگر را معطل نکند
+func (o *sendTimeOptimizer) profile(ctx context.Context, accountID string, now time.Time) (*openProfile, error) {
+	o.mu.Lock()
+	if p, ok := o.profiles[accountID]; ok && now.Sub(p.loadedAt) < sendTimeCacheTTL {
+		o.mu.Unlock()
+		return p, nil
+	}
+	if l, ok := o.loading[accountID]; ok {
+		o.mu.Unlock()
+		select {
+		case <-l.done:
+			return l.profile, l.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	for id, p := range o.profiles {
+		if now.Sub(p.loadedAt) >= sendTimeCacheTTL {
+			delete(o.profiles, id)
+		}
+	}
+	l := &profileLoad{done: make(chan struct{})}
+	o.loading[accountID] = l
+	o.mu.Unlock()
+
+	l.profile, l.err = o.load(ctx, accountID, now)
+
+	o.mu.Lock()
+	delete(o.loading, accountID)
+	if l.err == nil {
+		o.profiles[accountID] = l.profile
+	}
+	o.mu.Unlock()
+	close(l.done)
+	return l.profile, l.err
+}
+
+// load سابقه باز کردن‌های اکانت را از سرویس رویدادها می‌خواند
+func (o *sendTimeOptimizer) load(ctx context.Context, accountID string, now time.Time) (*openProfile, error) {
+	p := &openProfile{loadedAt: now, recipients: make(map[string]*[24]int32)}
+	filter := domain.EventFilter{Type: domain.EventOpened, From: now.Add(-sendTimeHistory), To: now}
+	err := forEachEvent(ctx, o.events, accountID, filter, func(e domain.EmailEvent) {
+		email := strings.ToLower(strings.TrimSpace(e.Email))
+		if email == "" || e.At.IsZero() {
+			return
+		}
+		h := p.recipients[email]
+		if h == nil {
+			h = new([24]int32)
+			p.recipients[email] = h
+		}
+		hour := e.At.UTC().Hour()
+		h[hour]++
+		p.account[hour]++
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load open history: %w", err)
+	}
+	return p, nil
+}
+
+// sendTimePlan زمان ارسال گیرندگان یک کمپین و توزیع انتخاب شده برای WinnerSendingTimeForHumans
+type sendTimePlan struct {
+	profile      *openProfile
+	start        time.Time
+	fallbackHour int // پرتکرارترین ساعت باز کردن در اکانت؛ -1 اگر سابقه‌ای نباشد
+
+	byHour                        [24]int
+	personal, fallback, immediate int
+}
+
+// at گیرنده بدون سابقه در بهترین ساعت اکانت و در نبود آن در زمان شروع ارسال می‌شود
+func (p *sendTimePlan) at(m domain.AudienceMember) (time.Time, bool) {
+	email := m.Email
+	hour := -1
+	if h, ok := p.profile.recipients[email]; ok {
+		hour = bestHour(h)
+		p.personal++
+	} else if p.fallbackHour >= 0 {
+		hour = p.fallbackHour
+		p.fallback++
+	}
+	if hour < 0 {
+		p.immediate++
+		return p.start, true
+	}
+	p.byHour[hour]++
+
+	// دقیقه ارسال بر اساس هش ایمیل پخش می‌شود تا همه گیرندگان یک ساعت همزمان وارد صف نشوند
+	s := p.start.UTC()
+	t := time.Date(s.Year(), s.Month(), s.Day(), hour, spreadMinute(email), 0, 0, time.UTC)
+	if t.Before(s) {
+		t = t.Add(24 * time.Hour)
+	}
+	return t, true
+}
+
+// summary مثلاً: Peak 14:00 UTC (31%); 72% by recipient history, 28% at account best hour 10:00 UTC
+func (p *sendTimePlan) summary() string {
+	total := p.personal + p.fallback + p.immediate
+	if total == 0 {
+		return ""
+	}
+	if p.personal+p.fallback == 0 {
+		return "No open history; sent at campaign start"
+	}
+	pct := func(n int) int { return n * 100 / total }
+
+	peak := 0
+	for h := range p.byHour {
+		if p.byHour[h] > p.byHour[peak] {
+			peak = h
+		}
+	}
+	s := fmt.Sprintf("Peak %02d:00 UTC (%d%%); %d%% by recipient history", peak, pct(p.byHour[peak]), pct(p.personal))
+	if p.fallback > 0 {
+		s += fmt.Sprintf(", %d%% at account best hour %02d:00 UTC", pct(p.fallback), p.fallbackHour)
+	}
+	return s
+}
+
+// bestHour پرتکرارترین ساعت؛ -1 اگر همه صفر باشند
+func bestHour(h *[24]int32) int {
+	best := -1
+	for hour, n := range h {
+		if n > 0 && (best < 0 || n > h[best]) {
+			best = hour
+		}
+	}
+	return best
+}
+
+func spreadMinute(email string) int {
+	f := fnv.New32a()
+	f.Write([]byte(email))
+	return int(f.Sum32() % 60)
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+)
+
+// campaignSenderCacheTTL کمپین هر آیتم از این حافظه خوانده می‌شود تا برای هر گیرنده یک کوئری زده نشود؛
+// توقف کمپین حداکثر با این تأخیر روی آیتم‌های صف اعمال می‌شود
+const campaignSenderCacheTTL = 30 * time.Second
+
+type cachedCampaign struct {
+	campaign *domain.Campaign
+	loadedAt time.Time
+}
+
+type campaignSender struct {
+	campaigns   port.ICampaignRepository
+	templates   port.ITemplateServices
+	mta         port.IMtaService
+	unsubscribe port.IUnsubscribeService // اختیاری؛ بدون آن سرآیند List-Unsubscribe اضافه نمی‌شود
+	tracking    port.ITrackingService    // اختیاری؛ بدون آن TrackOpens و TrackClicks نادیده گرفته می‌شوند
+	automations port.IAutomationRepository
+	messages    port.IMessageRepository // اختیاری همراه automations؛ بدون آن‌ها ایمیل‌های Journey ارسال نمی‌شوند
+
+	mu    sync.Mutex
+	cache map[string]cachedCampaign
+}
+
+func NewCampaignSender(campaigns port.ICampaignRepository, templates port.ITemplateServices, mta port.IMtaService,
+	unsubscribe port.IUnsubscribeService, tracking port.ITrackingService, automations port.IAutomationRepository,
+	messages port.IMessageRepository) port.ICampaignSender {
+	return &campaignSender{
+		campaigns:   campaigns,
+		templates:   templates,
+		mta:         mta,
+		unsubscribe: unsubscribe,
+		tracking:    tracking,
+		automations: automations,
+		messages:    messages,
+		cache:       make(map[string]cachedCampaign),
+	}
+}
+
+func (s *campaignSender) Handle(ctx context.Context, item *models.QueueItem) (string, error) {
+	if item.CampaignRef == "" {
+		if _, ok := item.RecipientData[journeyIDVar]; ok {
+			return s.handleJourney(ctx, item)
+		}
+		return "", fmt.Errorf("%w: queue item is not linked to a campaign", port.ErrPermanentFailure)
+	}
+	c, err := s.campaign(ctx, item.AccountID, item.CampaignRef)
+	if err != nil {
+		return "", err
+	}
+	if c.IsStopped || c.Status == domain.StatusCancelled || c.Status == domain.StatusFailed {
+		return "", fmt.Errorf("%w: campaign is %s", port.ErrPermanentFailure, c.Status)
+	}
+	templateID := campaignTemplateID(c)
+	if templateID == "" {
+		return "", fmt.Errorf("%w: campaign has no content", port.ErrPermanentFailure)
+	}
+
+	contact := make(map[string]string, len(item.RecipientData)+1)
+	for k, v := range item.RecipientData {
+		contact[k] = toString(v)
+	}
+	contact["email"] = item.RecipientEmail
+
+	rendered, err := s.templates.RenderSnapshot(ctx, item.AccountID, templateID, c.ID, contact)
+	if err != nil {
+		return "", err
+	}
+	body := rendered.HTML
+	// برچسب UTM پیش از رهگیری اضافه می‌شود تا مقصد لینک‌های رهگیری هم برچسب داشته باشد
+	if c.Options.UseGoogleAnalytics || c.Options.EcommerceTracking {
+		tags := utmTags(c.Name, campaignVariation(c, templateID), c.Options.UTMParameters)
+		if !c.Options.UseGoogleAnalytics {
+			tags.Params = nil
+		}
+		if c.Options.EcommerceTracking {
+			tags.Ecommerce = ecommerceIDs(c.ID, item.RecipientEmail)
+		}
+		if body, err = rewriteLinks(body, func(href string) string { return tagURL(href, tags) }, nil); err != nil {
+			return "", err
+		}
+	}
+	if s.tracking != nil && (c.Options.TrackOpens || c.Options.TrackClicks) {
+		token := domain.TrackingToken{AccountID: item.AccountID, CampaignID: c.ID, Email: item.RecipientEmail}
+		if body, err = s.tracking.Instrument(body, token, c.Options.TrackOpens, c.Options.TrackClicks); err != nil {
+			return "", err
+		}
+	}
+
+	email := &domain.OutboundEmail{
+		AccountID: item.AccountID,
+		To:        item.RecipientEmail,
+		Subject:   rendered.Subject, // Merge Tag ها در RenderSnapshot جایگذاری شده‌اند
+		HTML:      body,
+		Text:      rendered.Text,
+		Metadata: map[string]string{
+			"campaign_id":   c.ID,
+			"queue_item_id": item.ID.Hex(),
+		},
+	}
+	if s.unsubscribe != nil {
+		headers, err := s.unsubscribe.Headers(domain.UnsubscribeToken{
+			AccountID:  item.AccountID,
+			CampaignID: c.ID,
+			ListID:     contact[recipientListVar],
+			Email:      item.RecipientEmail,
+		})
+		if err != nil {
+			return "", err
+		}
+		email.Headers = headers
+	}
+	return s.mta.Send(ctx, email)
+}
+
+// campaignTemplateID قالبی که برای همه گیرندگان کمپین ارسال می‌شود
+func campaignTemplateID(c *domain.Campaign) string {
+	if c.DefaultEmailID == "" && len(c.EmailIDs) > 0 {
+		return c.EmailIDs[0]
+	}
+	return c.DefaultEmailID
+}
+
+// campaignVariation برچسب نسخه A/B قالب ارسالی (A، B، ... به ترتیب EmailIDs)؛ کمپین تک‌نسخه‌ای برچسب ندارد
+func campaignVariation(c *domain.Campaign, templateID string) string {
+	if len(c.EmailIDs) < 2 {
+		return ""
+	}
+	for i, id := range c.EmailIDs {
+		if id != templateID {
+			continue
+		}
+		if i < 26 {
+			return string(rune('A' + i))
+		}
+		return fmt.Sprintf("V%d", i+1)
+	}
+	return ""
+}
+
+func (s *campaignSender) campaign(ctx context.Context, accountID, id string) (*domain.Campaign, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if cached, ok := s.cache[id]; ok && now.Sub(cached.loadedAt) < campaignSenderCacheTTL {
+		return cached.campaign, nil
+	}
+	for k, cached := range s.cache {
+		if now.Sub(cached.loadedAt) >= campaignSenderCacheTTL {
+			delete(s.cache, k)
+		}
+	}
+	c, err := s.campaigns.GetByID(ctx, id, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("campaign %s: %w", id, err)
+	}
+	s.cache[id] = cachedCampaign{campaign: c, loadedAt: now}
+	return c, nil
+}
@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+)
+
+// حداکثر عمق بلاک‌های تو در تو (بلاکی که بلاک دیگری را ارجاع می‌دهد)
+const maxBlockDepth = 5
+
+var blockTagNameRe = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+type contentBlockServices struct {
+	repo      port.IContentBlockRepository
+	templates port.ITemplateRepository
+	linter    port.ITemplateLinter // اختیاری؛ برای Lint مجدد قالب‌ها بعد از تغییر بلاک
+}
+
+func NewContentBlockServices(repo port.IContentBlockRepository, templates port.ITemplateRepository, linter port.ITemplateLinter) port.IContentBlockService {
+	return &contentBlockServices{
+		repo:      repo,
+		templates: templates,
+		linter:    linter,
+	}
+}
+
+func (s *contentBlockServices) CreateBlock(ctx context.Context, b *domain.ContentBlock, v *domain.ContentBlockVersion) (*domain.ContentBlock, error) {
+	if b.Name == "" {
+		b.Name = b.Tag
+	}
+	if !blockTagNameRe.MatchString(b.Tag) {
+		return nil, errors.New("block tag may only contain letters, digits, '_', '-' and '.'")
+	}
+	if _, _, err := s.repo.GetBlockByTag(ctx, b.AccountID, b.Tag); err == nil {
+		return nil, fmt.Errorf("block with tag %q already exists", b.Tag)
+	}
+
+	if err := s.repo.SaveBlock(ctx, b); err != nil {
+		return nil, err
+	}
+	v.BlockID = b.ID
+	if err := s.repo.SaveBlockVersion(ctx, v); err != nil {
+		return nil, err
+	}
+	b.CurrentVersionID = v.ID
+	if err := s.repo.SaveBlock(ctx, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UpdateBlock: نسخه جدید بلاک فعلی می‌شود و قالب‌های وابسته دوباره رندر می‌شوند
+func (s *contentBlockServices) UpdateBlock(ctx context.Context, accountID, blockID string, v *domain.ContentBlockVersion) (*domain.ContentBlock, []domain.BlockUsage, error) {
+	b, _, err := s.repo.GetBlock(ctx, accountID, blockID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// جلوگیری از ارجاع بلاک به خودش
+	for _, tag := range domain.ExtractBlockTags(v.HTMLContent, v.PlainText) {
+		if tag == b.Tag {
+			return nil, nil, fmt.Errorf("block %q cannot reference itself", b.Tag)
+		}
+	}
+
+	v.BlockID = b.ID
+	if err := s.repo.SaveBlockVersion(ctx, v); err != nil {
+		return nil, nil, err
+	}
+	b.CurrentVersionID = v.ID
+	if err := s.repo.SaveBlock(ctx, b); err != nil {
+		return nil, nil, err
+	}
+
+	usages, err := s.rerender(ctx, accountID, b.Tag)
+	if err != nil {
+		return b, nil, err
+	}
+	return b, usages, nil
+}
+
+func (s *contentBlockServices) GetBlock(ctx context.Context, accountID, blockID string) (*domain.ContentBlock, *domain.ContentBlockVersion, error) {
+	return s.repo.GetBlock(ctx, accountID, blockID)
+}
+
+func (s *contentBlockServices) ListBlocks(ctx context.Context, accountID string) ([]*domain.ContentBlock, error) {
+	return s.repo.ListBlocks(ctx, accountID)
+}
+
+func (s *contentBlockServices) DeleteBlock(ctx context.Context, accountID, blockID string) error {
+	usages, err := s.ListBlockUsages(ctx, accountID, blockID)
+	if err != nil {
+		return err
+	}
+	if len(usages) > 0 {
+		return fmt.Errorf("block is used by %d template version(s)", len(usages))
+	}
+	return s.repo.DeleteBlock(ctx, accountID, blockID)
+}
+
+func (s *contentBlockServices) ListBlockUsages(ctx context.Context, accountID, blockID string) ([]domain.BlockUsage, error) {
+	b, _, err := s.repo.GetBlock(ctx, accountID, blockID)
+	if err != nil {
+		return nil, err
+	}
+	return s.templates.ListBlockUsages(ctx, accountID, b.Tag)
+}
+
+func (s *contentBlockServices) RerenderBlockUsages(ctx context.Context, accountID, blockID string) ([]domain.BlockUsage, error) {
+	b, _, err := s.repo.GetBlock(ctx, accountID, blockID)
+	if err != nil {
+		return nil, err
+	}
+	return s.rerender(ctx, accountID, b.Tag)
+}
+
+// rerender نسخه‌های فعلی وابسته را با محتوای جدید بلاک باز می‌کند و نتیجه Lint آن‌ها را به‌روز می‌کند
+func (s *contentBlockServices) rerender(ctx context.Context, accountID, tag string) ([]domain.BlockUsage, error) {
+	usages, err := s.templates.ListBlockUsages(ctx, accountID, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range usages {
+		v, err := s.templates.GetVersion(ctx, accountID, usages[i].VersionID)
+		if err != nil {
+			return nil, err
+		}
+		if v.HTMLContent, err = s.ExpandBlocks(ctx, accountID, v.HTMLContent, true); err != nil {
+			return nil, fmt.Errorf("template %s: %w", usages[i].TemplateID, err)
+		}
+		if v.PlainText, err = s.ExpandBlocks(ctx, accountID, v.PlainText, false); err != nil {
+			return nil, fmt.Errorf("template %s: %w", usages[i].TemplateID, err)
+		}
+
+		if s.linter != nil {
+			result := s.linter.Lint(ctx, v)
+			if err := s.templates.SaveLintResult(ctx, v.ID, result); err != nil {
+				return nil, err
+			}
+			usages[i].LintResult = result
+		}
+	}
+	return usages, nil
+}
+
+// ExpandBlocks تمام {{block "tag"}} ها را (به صورت بازگشتی تا maxBlockDepth) جایگزین می‌کند
+func (s *contentBlockServices) ExpandBlocks(ctx context.Context, accountID, content string, html bool) (string, error) {
+	cache := make(map[string]*domain.ContentBlockVersion)
+	return s.expand(ctx, accountID, content, html, cache, nil)
+}
+
+func (s *contentBlockServices) expand(ctx context.Context, accountID, content string, html bool,
+	cache map[string]*domain.ContentBlockVersion, stack []string) (string, error) {
+
+	if !domain.BlockTagRe.MatchString(content) {
+		return content, nil
+	}
+	if len(stack) >= maxBlockDepth {
+		return "", fmt.Errorf("blocks are nested too deeply: %s", strings.Join(stack, " -> "))
+	}
+
+	var expandErr error
+	out := domain.BlockTagRe.ReplaceAllStringFunc(content, func(match string) string {
+		if expandErr != nil {
+			return match
+		}
+		tag := domain.BlockTagRe.FindStringSubmatch(match)[1]
+		for _, parent := range stack {
+			if parent == tag {
+				expandErr = fmt.Errorf("circular block reference: %s -> %s", strings.Join(stack, " -> "), tag)
+				return match
+			}
+		}
+
+		v, ok := cache[tag]
+		if !ok {
+			_, cur, err := s.repo.GetBlockByTag(ctx, accountID, tag)
+			if err != nil {
+				expandErr = fmt.Errorf("block %q not found", tag)
+				return match
+			}
+			v = cur
+			cache[tag] = v
+		}
+
+		body := v.PlainText
+		if html {
+			body = v.HTMLContent
+		}
+		expanded, err := s.expand(ctx, accountID, body, html, cache, append(stack, tag))
+		if err != nil {
+			expandErr = err
+			return match
+		}
+		return expanded
+	})
+	if expandErr != nil {
+		return "", expandErr
+	}
+	return out, nil
+}
@@ -0,0 +1,205 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	maxBulkReplay  = 10000 // حداکثر آیتم در یک Replay گروهی
+	replayPageSize = 500
+)
+
+type deadLetterServices struct {
+	repo port.IDeadLetterRepository
+}
+
+func NewDeadLetterServices(repo port.IDeadLetterRepository) port.IDeadLetterService {
+	return &deadLetterServices{repo: repo}
+}
+
+func (s *deadLetterServices) ListDeadLetters(ctx context.Context, accountID string, filter domain.DeadLetterFilter, limit, offset int) ([]*models.DeadLetter, int, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	filter.Email = strings.ToLower(strings.TrimSpace(filter.Email))
+	return s.repo.ListDeadLetters(ctx, accountID, filter, limit, offset)
+}
+
+func (s *deadLetterServices) GetDeadLetter(ctx context.Context, accountID, id string) (*models.DeadLetter, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, errors.New("invalid dead letter id")
+	}
+	return s.repo.GetDeadLetter(ctx, accountID, oid)
+}
+
+func (s *deadLetterServices) SummarizeDeadLetters(ctx context.Context, accountID, campaignID string) ([]domain.DeadLetterSummary, error) {
+	return s.repo.SummarizeDeadLetters(ctx, accountID, campaignID)
+}
+
+func (s *deadLetterServices) ReplayDeadLetter(ctx context.Context, accountID, id string, edit domain.DeadLetterEdit) (*models.QueueBatch, error) {
+	d, err := s.GetDeadLetter(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+	if d.Status != models.DEAD_LETTER_DEAD {
+		return nil, fmt.Errorf("dead letter was already replayed (status: %s)", d.Status)
+	}
+	if edit.RecipientEmail = strings.TrimSpace(edit.RecipientEmail); edit.RecipientEmail != "" && !strings.Contains(edit.RecipientEmail, "@") {
+		return nil, errors.New("invalid recipient email")
+	}
+	return s.replay(ctx, accountID, d.CampaignID, []*models.DeadLetter{d}, edit)
+}
+
+func (s *deadLetterServices) ReplayDeadLetters(ctx context.Context, accountID string, ids []string, filter domain.DeadLetterFilter,
+	edit domain.DeadLetterEdit, max int) (*domain.DeadLetterReplayResult, error) {
+	if strings.TrimSpace(edit.RecipientEmail) != "" {
+		return nil, errors.New("recipient email can only be changed when replaying a single dead letter")
+	}
+	if max <= 0 || max > maxBulkReplay {
+		max = maxBulkReplay
+	}
+
+	var letters []*models.DeadLetter
+	if len(ids) > 0 {
+		if len(ids) > max {
+			return nil, fmt.Errorf("at most %d dead letters can be replayed at once", max)
+		}
+		for _, id := range ids {
+			d, err := s.GetDeadLetter(ctx, accountID, id)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", id, err)
+			}
+			if d.Status != models.DEAD_LETTER_DEAD {
+				return nil, fmt.Errorf("dead letter %s was already replayed", id)
+			}
+			letters = append(letters, d)
+		}
+	} else {
+		filter.Status = string(models.DEAD_LETTER_DEAD)
+		filter.Email = strings.ToLower(strings.TrimSpace(filter.Email))
+		for len(letters) < max {
+			page, _, err := s.repo.ListDeadLetters(ctx, accountID, filter, min(replayPageSize, max-len(letters)), len(letters))
+			if err != nil {
+				return nil, err
+			}
+			if len(page) == 0 {
+				break
+			}
+			letters = append(letters, page...)
+		}
+	}
+	if len(letters) == 0 {
+		return nil, errors.New("no dead letters to replay")
+	}
+
+	// هر دسته صف فقط یک کمپین دارد؛ کمپین‌های MTA شناسه ObjectID ندارند و با CampaignRef از هم جدا می‌شوند
+	var order []replayGroupKey
+	groups := make(map[replayGroupKey][]*models.DeadLetter)
+	for _, d := range letters {
+		key := replayGroupKey{campaignID: d.CampaignID}
+		if d.CampaignID.IsZero() {
+			key.campaignRef = d.Payload.CampaignRef
+		}
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], d)
+	}
+
+	result := &domain.DeadLetterReplayResult{}
+	for _, key := range order {
+		batch, err := s.replay(ctx, accountID, key.campaignID, groups[key], edit)
+		if err != nil {
+			return result, err
+		}
+		result.Replayed += batch.ItemCount
+		result.BatchIDs = append(result.BatchIDs, batch.ID.Hex())
+	}
+	return result, nil
+}
+
+func (s *deadLetterServices) PurgeDeadLetters(ctx context.Context, accountID string, filter domain.DeadLetterFilter, before time.Time) (int, error) {
+	filter.Email = strings.ToLower(strings.TrimSpace(filter.Email))
+	if filter == (domain.DeadLetterFilter{}) && before.IsZero() {
+		return 0, errors.New("purge requires a filter or a cutoff time")
+	}
+	return s.repo.PurgeDeadLetters(ctx, accountID, filter, before)
+}
+
+// ---------------------------------------------------------
+// توابع کمکی
+// ---------------------------------------------------------
+
+// replayGroupKey کلید دسته‌بندی Replay گروهی؛ campaignRef فقط وقتی campaignID صفر است پر می‌شود
+type replayGroupKey struct {
+	campaignID  primitive.ObjectID
+	campaignRef string
+}
+
+// replay آیتم‌های جدید صف را از Payload می‌سازد؛ تاریخچه خطاها حفظ و شمارنده تلاش از صفر شروع می‌شود
+func (s *deadLetterServices) replay(ctx context.Context, accountID string, campaignID primitive.ObjectID,
+	letters []*models.DeadLetter, edit domain.DeadLetterEdit) (*models.QueueBatch, error) {
+	items := make([]*models.QueueItem, len(letters))
+	ids := make([]primitive.ObjectID, len(letters))
+	for i, d := range letters {
+		p := d.Payload
+		item := &models.QueueItem{
+			CampaignRef:    p.CampaignRef,
+			MessageID:      p.MessageID,
+			RecipientEmail: p.RecipientEmail,
+			RecipientData:  mergeRecipientData(p.RecipientData, edit.RecipientData),
+			SendingDomain:  p.SendingDomain,
+			Priority:       p.Priority,
+			MaxRetries:     p.MaxRetries,
+			ErrorHistory:   p.ErrorHistory,
+			QueueName:      p.QueueName,
+			Exchange:       p.Exchange,
+			RoutingKey:     p.RoutingKey,
+			Server:         p.Server,
+		}
+		if edit.RecipientEmail != "" {
+			item.RecipientEmail = edit.RecipientEmail
+		}
+		if edit.Priority > 0 {
+			item.Priority = models.QueuePriority(edit.Priority)
+		}
+		items[i] = item
+		ids[i] = d.ID
+	}
+
+	now := time.Now()
+	batch, err := newQueueBatch(accountID, campaignID, items, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.ReplayDeadLetters(ctx, batch, items, ids, now); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+// mergeRecipientData تغییرات روی کپی داده گیرنده اعمال می‌شوند؛ مقدار nil کلید را حذف می‌کند
+func mergeRecipientData(data, changes map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(data)+len(changes))
+	for k, v := range data {
+		merged[k] = v
+	}
+	for k, v := range changes {
+		if v == nil {
+			delete(merged, k)
+			continue
+		}
+		merged[k] = v
+	}
+	return merged
+}
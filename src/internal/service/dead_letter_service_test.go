@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// fakeDeadLetterRepo آیتم‌ها را در حافظه نگه می‌دارد و دسته‌های Replay شده را ثبت می‌کند
+type fakeDeadLetterRepo struct {
+	letters map[primitive.ObjectID]*models.DeadLetter
+	batches []*models.QueueBatch
+	items   [][]*models.QueueItem
+}
+
+func (r *fakeDeadLetterRepo) ListDeadLetters(ctx context.Context, accountID string, filter domain.DeadLetterFilter, limit, offset int) ([]*models.DeadLetter, int, error) {
+	return nil, 0, nil
+}
+
+func (r *fakeDeadLetterRepo) GetDeadLetter(ctx context.Context, accountID string, id primitive.ObjectID) (*models.DeadLetter, error) {
+	return r.letters[id], nil
+}
+
+func (r *fakeDeadLetterRepo) SummarizeDeadLetters(ctx context.Context, accountID, campaignID string) ([]domain.DeadLetterSummary, error) {
+	return nil, nil
+}
+
+func (r *fakeDeadLetterRepo) ReplayDeadLetters(ctx context.Context, batch *models.QueueBatch, items []*models.QueueItem, letterIDs []primitive.ObjectID, now time.Time) error {
+	batch.ItemCount = len(items) // مثل ریپازیتوری postgres
+	r.batches = append(r.batches, batch)
+	r.items = append(r.items, items)
+	return nil
+}
+
+func (r *fakeDeadLetterRepo) PurgeDeadLetters(ctx context.Context, accountID string, filter domain.DeadLetterFilter, before time.Time) (int, error) {
+	return 0, nil
+}
+
+func TestReplayDeadLettersGroupsByCampaign(t *testing.T) {
+	automationID := primitive.NewObjectID()
+	letter := func(campaignID primitive.ObjectID, ref string, data map[string]interface{}) *models.DeadLetter {
+		return &models.DeadLetter{
+			ID:         primitive.NewObjectID(),
+			AccountID:  "acc",
+			CampaignID: campaignID,
+			Status:     models.DEAD_LETTER_DEAD,
+			Payload: models.QueueItem{
+				CampaignID:     campaignID,
+				CampaignRef:    ref,
+				RecipientEmail: "user@example.com",
+				RecipientData:  data,
+			},
+		}
+	}
+	journey := letter(automationID, "", map[string]interface{}{
+		journeyAutomationVar: automationID.Hex(),
+		journeyIDVar:         primitive.NewObjectID().Hex(),
+		journeyStepVar:       primitive.NewObjectID().Hex(),
+	})
+	letters := []*models.DeadLetter{
+		letter(primitive.NilObjectID, "campaign-a", nil),
+		journey,
+		letter(primitive.NilObjectID, "campaign-b", nil),
+		letter(primitive.NilObjectID, "campaign-a", nil),
+	}
+
+	repo := &fakeDeadLetterRepo{letters: make(map[primitive.ObjectID]*models.DeadLetter)}
+	var ids []string
+	for _, d := range letters {
+		repo.letters[d.ID] = d
+		ids = append(ids, d.ID.Hex())
+	}
+
+	res, err := NewDeadLetterServices(repo).ReplayDeadLetters(context.Background(), "acc", ids, domain.DeadLetterFilter{}, domain.DeadLetterEdit{}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Replayed != len(letters) || len(res.BatchIDs) != 3 {
+		t.Fatalf("replayed %d items in %d batches, want %d in 3", res.Replayed, len(res.BatchIDs), len(letters))
+	}
+
+	// دسته‌ها به ترتیب اولین آیتم هر کمپین ساخته می‌شوند
+	wantRefs := [][]string{{"campaign-a", "campaign-a"}, {""}, {"campaign-b"}}
+	for i, items := range repo.items {
+		if len(items) != len(wantRefs[i]) {
+			t.Fatalf("batch %d has %d items, want %d", i, len(items), len(wantRefs[i]))
+		}
+		for j, it := range items {
+			if it.CampaignRef != wantRefs[i][j] {
+				t.Errorf("batch %d item %d campaign_ref = %q, want %q", i, j, it.CampaignRef, wantRefs[i][j])
+			}
+		}
+	}
+
+	// آیتم Journey با شناسه اتوماسیون و متغیرهای Journey دوباره به صف می‌رود تا campaignSender آن را بشناسد
+	batch, item := repo.batches[1], repo.items[1][0]
+	if batch.CampaignID != automationID || item.CampaignID != automationID {
+		t.Errorf("journey batch campaign_id = %s, item campaign_id = %s; want %s", batch.CampaignID.Hex(), item.CampaignID.Hex(), automationID.Hex())
+	}
+	for _, key := range []string{journeyAutomationVar, journeyIDVar, journeyStepVar} {
+		if item.RecipientData[key] != journey.Payload.RecipientData[key] {
+			t.Errorf("journey item %s = %v, want %v", key, item.RecipientData[key], journey.Payload.RecipientData[key])
+		}
+	}
+	for _, b := range []*models.QueueBatch{repo.batches[0], repo.batches[2]} {
+		if !b.CampaignID.IsZero() {
+			t.Errorf("MTA campaign batch campaign_id = %s, want zero", b.CampaignID.Hex())
+		}
+	}
+}
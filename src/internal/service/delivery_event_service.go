@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultSoftBounceLimit  = 3
+	defaultSoftBounceWindow = 72 * time.Hour
+	deliveryEventBatch      = 500 // رویدادهای هر دسته در Consume
+	deliveryEventFlushEvery = 2 * time.Second
+	processedEventRetention = 7 * 24 * time.Hour // حذف تکرار فقط برای تحویل دوباره همان پیام لازم است
+)
+
+// campaignStatEvents رویدادهایی که در Stats کمپین شمرده می‌شوند
+var campaignStatEvents = map[string]bool{
+	domain.EventSent: true, domain.EventBounced: true, domain.EventSoftBounced: true,
+	domain.EventComplained: true, domain.EventUnsubscribed: true, domain.EventOpened: true, domain.EventClicked: true,
+}
+
+type deliveryEventService struct {
+	campaigns    port.ICampaignRepository
+	client       port.ISuppressionClient
+	repo         port.ISuppressionRepository
+	suppressions port.ISuppressionService // اختیاری؛ فهرست در حافظه صف ارسال بدون انتظار برای بارگذاری دوباره به‌روز می‌شود
+	throttle     port.IDomainThrottle     // اختیاری؛ Bounce و Soft Bounce ها نرخ ارسال به دامنه را کاهش می‌دهند
+
+	softBounceLimit  int
+	softBounceWindow time.Duration
+}
+
+// softBounceLimit تعداد Soft Bounce های پیاپی در softBounceWindow که گیرنده را Suppress می‌کند؛ صفر یعنی پیش‌فرض (۳ در ۷۲ ساعت)
+func NewDeliveryEventService(campaigns port.ICampaignRepository, client port.ISuppressionClient, repo port.ISuppressionRepository,
+	suppressions port.ISuppressionService, throttle port.IDomainThrottle, softBounceLimit int, softBounceWindow time.Duration) port.IDeliveryEventService {
+	if softBounceLimit <= 0 {
+		softBounceLimit = defaultSoftBounceLimit
+	}
+	if softBounceWindow <= 0 {
+		softBounceWindow = defaultSoftBounceWindow
+	}
+	return &deliveryEventService{
+		campaigns:        campaigns,
+		client:           client,
+		repo:             repo,
+		suppressions:     suppressions,
+		throttle:         throttle,
+		softBounceLimit:  softBounceLimit,
+		softBounceWindow: softBounceWindow,
+	}
+}
+
+// HandleEvents ترتیب ثبت: Suppression ها (تکرار آن‌ها بی‌اثر است)، آمار دامنه‌ها و سپس آمار کمپین؛ شمارنده‌ها
+// (Soft Bounce، آمار دامنه و کمپین) رویداد دارای شناسه را یک بار حساب می‌کنند و تکرار دسته بعد از خطا بی‌خطر است
+//
+// Hard Bounce و Complaint علاوه بر فهرست اکانت در فهرست سراسری هم ثبت می‌شوند؛ لغو عضویت فقط در فهرست اکانت.
+func (s *deliveryEventService) HandleEvents(ctx context.Context, events []domain.EmailEvent) error {
+	suppress := make(map[string][]domain.Suppression) // اکانت ← موارد جدید
+	delivered := make(map[string][]string)
+	var global []domain.Suppression
+	byCampaign := make(map[[2]string][]domain.EmailEvent)
+
+	for _, e := range events {
+		e.Email = strings.ToLower(strings.TrimSpace(e.Email))
+		if e.AccountID == "" || e.Email == "" {
+			continue
+		}
+		if e.At.IsZero() {
+			e.At = time.Now()
+		}
+
+		reason := ""
+		switch e.Type {
+		case domain.EventBounced:
+			reason = domain.SuppressionBounce
+			global = append(global, domain.Suppression{Email: e.Email, Reason: reason, CreatedAt: e.At})
+		case domain.EventComplained:
+			reason = domain.SuppressionComplaint
+			global = append(global, domain.Suppression{Email: e.Email, Reason: reason, CreatedAt: e.At})
+		case domain.EventUnsubscribed:
+			reason = domain.SuppressionUnsubscribe
+		case domain.EventSoftBounced:
+			count, err := s.repo.RecordSoftBounce(ctx, e.ID, e.AccountID, e.Email, e.At, s.softBounceWindow)
+			if err != nil {
+				return fmt.Errorf("record soft bounce: %w", err)
+			}
+			if count >= s.softBounceLimit {
+				reason = domain.SuppressionBounce
+			}
+		case domain.EventDelivered:
+			delivered[e.AccountID] = append(delivered[e.AccountID], e.Email)
+		}
+		if reason != "" {
+			suppress[e.AccountID] = append(suppress[e.AccountID], domain.Suppression{Email: e.Email, Reason: reason, CreatedAt: e.At})
+		}
+
+		if campaignStatEvents[e.Type] && e.CampaignID != "" {
+			if _, err := uuid.Parse(e.CampaignID); err == nil {
+				key := [2]string{e.AccountID, e.CampaignID}
+				byCampaign[key] = append(byCampaign[key], e)
+			}
+		}
+	}
+
+	for accountID, emails := range delivered {
+		if err := s.repo.ResetSoftBounces(ctx, accountID, emails); err != nil {
+			return fmt.Errorf("reset soft bounces: %w", err)
+		}
+	}
+	if err := s.repo.AddGlobalSuppressions(ctx, global); err != nil {
+		return fmt.Errorf("add global suppressions: %w", err)
+	}
+	for accountID, items := range suppress {
+		if _, err := s.client.BulkAddSuppressions(ctx, accountID, items); err != nil {
+			return fmt.Errorf("add suppressions of account %s: %w", accountID, err)
+		}
+		if s.suppressions != nil {
+			for _, item := range items {
+				s.suppressions.Suppressed(accountID, item.Email, item.Reason)
+			}
+		}
+	}
+	if s.throttle != nil {
+		if err := s.throttle.RecordEvents(ctx, events); err != nil {
+			return fmt.Errorf("domain stats: %w", err)
+		}
+	}
+	for key, list := range byCampaign {
+		if err := s.campaigns.ApplyEvents(ctx, key[0], key[1], list); err != nil {
+			return fmt.Errorf("campaign %s stats: %w", key[1], err)
+		}
+	}
+	return nil
+}
+
+// Consume هر پیام یک EmailEvent به صورت JSON است؛ پیام نامعتبر بدون requeue دور ریخته می‌شود
+//
+// دسته با رسیدن به deliveryEventBatch رویداد یا هر deliveryEventFlushEvery ثبت می‌شود. رویدادهای هر اکانت
+// جداگانه ثبت و Ack می‌شوند تا خطای یک اکانت بقیه را برنگرداند؛ پیام ناموفق یک بار به صف برمی‌گردد و اگر در
+// تحویل دوباره هم ثبت نشود با لاگ کنار گذاشته می‌شود تا صف در حلقه بی‌پایان نیفتد.
+func (s *deliveryEventService) Consume(ctx context.Context, deliveries <-chan port.Delivery) error {
+	type accountBatch struct {
+		deliveries []port.Delivery
+		events     []domain.EmailEvent
+	}
+	var (
+		pending   = make(map[string]*accountBatch)
+		count     int
+		lastPrune time.Time
+	)
+	flush := func() {
+		for accountID, b := range pending {
+			err := s.HandleEvents(context.WithoutCancel(ctx), b.events)
+			if err != nil {
+				log.Printf("⚠️ delivery events of account %s: %v (%d messages)", accountID, err, len(b.deliveries))
+			}
+			for _, d := range b.deliveries {
+				var ackErr error
+				switch {
+				case err == nil:
+					ackErr = d.Ack()
+				case d.Redelivered():
+					log.Printf("⚠️ delivery events: dropping message %s after a failed redelivery", d.Message().MessageID)
+					ackErr = d.Nack(false)
+				default:
+					ackErr = d.Nack(true)
+				}
+				if ackErr != nil {
+					log.Printf("⚠️ delivery events: ack: %v", ackErr)
+				}
+			}
+		}
+		pending, count = make(map[string]*accountBatch), 0
+
+		if now := time.Now(); now.Sub(lastPrune) >= time.Hour {
+			lastPrune = now
+			if err := s.repo.PruneProcessedEvents(context.WithoutCancel(ctx), now.Add(-processedEventRetention)); err != nil {
+				log.Printf("⚠️ delivery events: prune processed events: %v", err)
+			}
+		}
+	}
+
+	ticker := time.NewTicker(deliveryEventFlushEvery)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			flush()
+			return ctx.Err()
+		case <-ticker.C:
+			flush()
+		case d, ok := <-deliveries:
+			if !ok {
+				flush()
+				return nil
+			}
+			var e domain.EmailEvent
+			if err := json.Unmarshal(d.Message().Body, &e); err != nil {
+				log.Printf("⚠️ delivery events: invalid message %s: %v", d.Message().MessageID, err)
+				if err := d.Nack(false); err != nil {
+					log.Printf("⚠️ delivery events: nack: %v", err)
+				}
+				continue
+			}
+			b := pending[e.AccountID]
+			if b == nil {
+				b = &accountBatch{}
+				pending[e.AccountID] = b
+			}
+			b.deliveries = append(b.deliveries, d)
+			b.events = append(b.events, e)
+			if count++; count >= deliveryEventBatch {
+				flush()
+			}
+		}
+	}
+}
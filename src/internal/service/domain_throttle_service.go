@@ -0,0 +1,392 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	throttleCacheTTL    = time.Minute      // قوانین و آمار هر اکانت حداکثر این مدت از حافظه خوانده می‌شوند
+	throttleStatsWindow = time.Hour        // بازه آمار اخیر برای کاهش خودکار نرخ
+	throttleStatsBucket = 5 * time.Minute  // طول بازه‌های شمارنده رویدادهای دامنه
+	throttleStatsKeep   = 24 * time.Hour   // شمارنده‌های قدیمی‌تر حذف می‌شوند
+	throttleMinSample   = 100              // کمتر از این تعداد ارسال برای تصمیم‌گیری کافی نیست
+	bucketIdleExpiry    = 10 * time.Minute // Bucket بدون استفاده حذف می‌شود
+	throttleMinDefer    = time.Second
+
+	// آستانه‌های کاهش نرخ؛ عبور از دو برابر آستانه نرخ را به یک چهارم می‌رساند
+	bounceBackoffRate   = 0.05
+	deferralBackoffRate = 0.10
+)
+
+// ispDomains دامنه‌های دیگر یک ISP که با دامنه اصلی آن یک Bucket مشترک دارند
+var ispDomains = map[string]string{
+	"googlemail.com": "gmail.com",
+	"hotmail.com":    "outlook.com",
+	"live.com":       "outlook.com",
+	"msn.com":        "outlook.com",
+	"ymail.com":      "yahoo.com",
+	"rocketmail.com": "yahoo.com",
+	"me.com":         "icloud.com",
+	"mac.com":        "icloud.com",
+}
+
+// defaultThrottleRules نرخ پیش‌فرض ISP های بزرگ وقتی قانونی برای آن‌ها تنظیم نشده است
+var defaultThrottleRules = map[string]models.DomainThrottleRule{
+	"gmail.com":   {RecipientDomain: "gmail.com", PerMinute: 1200, Burst: 100},
+	"yahoo.com":   {RecipientDomain: "yahoo.com", PerMinute: 600, Burst: 50},
+	"outlook.com": {RecipientDomain: "outlook.com", PerMinute: 600, Burst: 50},
+	"aol.com":     {RecipientDomain: "aol.com", PerMinute: 300, Burst: 30},
+	"icloud.com":  {RecipientDomain: "icloud.com", PerMinute: 300, Burst: 30},
+}
+
+type domainThrottle struct {
+	rules port.IDomainThrottleRepository
+
+	mu         sync.Mutex
+	ruleCache  map[string]*cachedThrottleRules
+	statsCache map[string]*cachedDomainStats
+	prunedAt   time.Time
+}
+
+type cachedThrottleRules struct {
+	at    time.Time
+	rules []*models.DomainThrottleRule
+}
+
+// cachedDomainStats آمار به تفکیک دامنه اصلی ISP
+type cachedDomainStats struct {
+	at    time.Time
+	stats map[string]models.DomainStat
+}
+
+func NewDomainThrottle(rules port.IDomainThrottleRepository) port.IDomainThrottle {
+	return &domainThrottle{
+		rules:      rules,
+		ruleCache:  make(map[string]*cachedThrottleRules),
+		statsCache: make(map[string]*cachedDomainStats),
+	}
+}
+
+func (t *domainThrottle) Reserve(ctx context.Context, accountID, sendingDomain, recipientEmail string, now time.Time) (time.Duration, error) {
+	rd := ispDomain(emailDomain(recipientEmail))
+	if rd == "" {
+		return 0, nil
+	}
+	sendingDomain = strings.ToLower(strings.TrimSpace(sendingDomain))
+
+	rules, err := t.accountRules(ctx, accountID, now)
+	if err != nil {
+		return 0, err
+	}
+	rule := matchThrottleRule(rules, accountID, sendingDomain, rd)
+	if rule == nil || rule.PerMinute <= 0 {
+		return 0, nil
+	}
+	factor := backoffFactor(t.accountStats(ctx, accountID, now)[rd])
+	rate := float64(rule.PerMinute) * factor / 60
+	burst := math.Max(1, float64(rule.Burst)*factor)
+
+	t.prune(ctx, now)
+	return t.rules.TakeToken(ctx, accountID+"|"+sendingDomain+"|"+rd, now, rate, burst)
+}
+
+// RecordEvents فقط رویدادهایی که وضعیت تحویل را نشان می‌دهند در بازه‌های throttleStatsBucket شمرده می‌شوند؛
+// رویداد دارای شناسه جداگانه ارسال می‌شود تا تحویل دوباره آن در آمار تکرار نشود
+func (t *domainThrottle) RecordEvents(ctx context.Context, events []domain.EmailEvent) error {
+	type countKey struct {
+		account, domain, eventID string
+		window                   time.Time
+	}
+	counts := make(map[countKey]*models.DomainEventCount)
+	for _, e := range events {
+		rd := ispDomain(emailDomain(e.Email))
+		if e.AccountID == "" || rd == "" {
+			continue
+		}
+		at := e.At
+		if at.IsZero() {
+			at = time.Now()
+		}
+		key := countKey{e.AccountID, rd, e.ID, at.UTC().Truncate(throttleStatsBucket)}
+		c := counts[key]
+		if c != nil && e.ID != "" {
+			continue // تکرار رویداد در همین دسته
+		}
+		if c == nil {
+			c = &models.DomainEventCount{EventID: e.ID, AccountID: key.account, RecipientDomain: key.domain, WindowStart: key.window}
+		}
+		switch e.Type {
+		case domain.EventSent:
+			c.Sent++
+		case domain.EventDelivered:
+			c.Delivered++
+		case domain.EventBounced:
+			c.Bounced++
+		case domain.EventSoftBounced:
+			c.Deferred++
+		default:
+			continue
+		}
+		counts[key] = c
+	}
+
+	list := make([]models.DomainEventCount, 0, len(counts))
+	for _, c := range counts {
+		list = append(list, *c)
+	}
+	return t.rules.RecordDomainEvents(ctx, list)
+}
+
+func (t *domainThrottle) ListRules(ctx context.Context, accountID string) ([]*models.DomainThrottleRule, error) {
+	return t.rules.ListRules(ctx, accountID)
+}
+
+func (t *domainThrottle) SetRule(ctx context.Context, rule *models.DomainThrottleRule) (*models.DomainThrottleRule, error) {
+	if rule.AccountID == "" {
+		return nil, errors.New("account id is required")
+	}
+	rule.RecipientDomain = ispDomain(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(rule.RecipientDomain)), "@"))
+	if rule.RecipientDomain == "" || !strings.Contains(rule.RecipientDomain, ".") {
+		return nil, errors.New("invalid recipient domain")
+	}
+	rule.SendingDomain = strings.ToLower(strings.TrimSpace(rule.SendingDomain))
+	if rule.PerMinute <= 0 {
+		return nil, errors.New("per_minute must be positive")
+	}
+	if rule.Burst <= 0 {
+		rule.Burst = max(1, rule.PerMinute/10)
+	}
+
+	now := time.Now()
+	rule.ID = primitive.NewObjectID()
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+	if err := t.rules.SaveRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	t.invalidate(rule.AccountID)
+	return rule, nil
+}
+
+func (t *domainThrottle) DeleteRule(ctx context.Context, accountID, ruleID string) error {
+	id, err := primitive.ObjectIDFromHex(ruleID)
+	if err != nil {
+		return errors.New("invalid rule id")
+	}
+	if err := t.rules.DeleteRule(ctx, accountID, id); err != nil {
+		return err
+	}
+	t.invalidate(accountID)
+	return nil
+}
+
+func (t *domainThrottle) GetDomainPacing(ctx context.Context, accountID, sendingDomain string) ([]domain.DomainPacing, error) {
+	now := time.Now()
+	sendingDomain = strings.ToLower(strings.TrimSpace(sendingDomain))
+	rules, err := t.accountRules(ctx, accountID, now)
+	if err != nil {
+		return nil, err
+	}
+	stats := t.accountStats(ctx, accountID, now)
+
+	domains := make(map[string]bool, len(defaultThrottleRules)+len(rules))
+	for d := range defaultThrottleRules {
+		domains[d] = true
+	}
+	for _, r := range rules {
+		domains[r.RecipientDomain] = true
+	}
+
+	var pacing []domain.DomainPacing
+	for d := range domains {
+		rule := matchThrottleRule(rules, accountID, sendingDomain, d)
+		if rule == nil {
+			continue
+		}
+		factor := backoffFactor(stats[d])
+		pacing = append(pacing, domain.DomainPacing{
+			RecipientDomain: d,
+			PerMinute:       rule.PerMinute,
+			EffectiveRate:   float64(rule.PerMinute) * factor,
+			BackoffFactor:   factor,
+			Stat:            stats[d],
+		})
+	}
+	sort.Slice(pacing, func(i, j int) bool { return pacing[i].RecipientDomain < pacing[j].RecipientDomain })
+	return pacing, nil
+}
+
+// ---------------------------------------------------------
+// توابع کمکی
+// ---------------------------------------------------------
+
+// accountRules قوانین اکانت از حافظه؛ اگر خواندن دوباره شکست بخورد قوانین قبلی استفاده می‌شوند
+func (t *domainThrottle) accountRules(ctx context.Context, accountID string, now time.Time) ([]*models.DomainThrottleRule, error) {
+	t.mu.Lock()
+	cached := t.ruleCache[accountID]
+	t.mu.Unlock()
+	if cached != nil && now.Sub(cached.at) < throttleCacheTTL {
+		return cached.rules, nil
+	}
+
+	rules, err := t.rules.ListRules(ctx, accountID)
+	if err != nil {
+		if cached != nil {
+			return cached.rules, nil
+		}
+		return nil, err
+	}
+	t.mu.Lock()
+	t.ruleCache[accountID] = &cachedThrottleRules{at: now, rules: rules}
+	t.mu.Unlock()
+	return rules, nil
+}
+
+// accountStats آمار اخیر اکانت؛ در صورت خطا نرخ‌ها بدون کاهش اعمال می‌شوند
+func (t *domainThrottle) accountStats(ctx context.Context, accountID string, now time.Time) map[string]models.DomainStat {
+	t.mu.Lock()
+	cached := t.statsCache[accountID]
+	t.mu.Unlock()
+	if cached != nil && now.Sub(cached.at) < throttleCacheTTL {
+		return cached.stats
+	}
+
+	raw, err := t.rules.RecentDomainStats(ctx, accountID, now.Add(-throttleStatsWindow))
+	if err != nil {
+		log.Printf("⚠️ domain throttle: failed to load domain stats for %s: %v", accountID, err)
+		if cached != nil {
+			return cached.stats
+		}
+		return nil
+	}
+	stats := mergeISPStats(raw)
+	t.mu.Lock()
+	t.statsCache[accountID] = &cachedDomainStats{at: now, stats: stats}
+	t.mu.Unlock()
+	return stats
+}
+
+func (t *domainThrottle) invalidate(accountID string) {
+	t.mu.Lock()
+	delete(t.ruleCache, accountID)
+	t.mu.Unlock()
+}
+
+// prune هر نمونه حداکثر هر bucketIdleExpiry یک بار Bucket های بدون استفاده و آمار قدیمی را حذف می‌کند
+func (t *domainThrottle) prune(ctx context.Context, now time.Time) {
+	t.mu.Lock()
+	due := now.Sub(t.prunedAt) >= bucketIdleExpiry
+	if due {
+		t.prunedAt = now
+	}
+	t.mu.Unlock()
+	if !due {
+		return
+	}
+	if err := t.rules.PruneThrottleState(ctx, now.Add(-bucketIdleExpiry), now.Add(-throttleStatsKeep)); err != nil {
+		log.Printf("⚠️ domain throttle: prune: %v", err)
+	}
+}
+
+// matchThrottleRule خاص‌ترین قانون برای دامنه گیرنده؛ در نبود قانون، پیش‌فرض ISP (یا nil)
+func matchThrottleRule(rules []*models.DomainThrottleRule, accountID, sendingDomain, recipientDomain string) *models.DomainThrottleRule {
+	var best *models.DomainThrottleRule
+	bestScore := -1
+	for _, r := range rules {
+		if r.RecipientDomain != recipientDomain || (r.SendingDomain != "" && r.SendingDomain != sendingDomain) {
+			continue
+		}
+		if r.AccountID != "" && r.AccountID != accountID {
+			continue
+		}
+		score := 0
+		if r.AccountID != "" {
+			score += 2
+		}
+		if r.SendingDomain != "" {
+			score++
+		}
+		if score > bestScore {
+			best, bestScore = r, score
+		}
+	}
+	if best != nil {
+		return best
+	}
+	if def, ok := defaultThrottleRules[recipientDomain]; ok {
+		return &def
+	}
+	return nil
+}
+
+// backoffFactor ضریب کاهش نرخ بر اساس نرخ Bounce و تعویق اخیر دامنه
+func backoffFactor(st models.DomainStat) float64 {
+	if st.Count < throttleMinSample {
+		return 1
+	}
+	switch {
+	case st.BounceRate >= 2*bounceBackoffRate || st.DeferralRate >= 2*deferralBackoffRate:
+		return 0.25
+	case st.BounceRate >= bounceBackoffRate || st.DeferralRate >= deferralBackoffRate:
+		return 0.5
+	}
+	return 1
+}
+
+// mergeISPStats آمار دامنه‌های یک ISP را (میانگین وزنی بر اساس Count) زیر دامنه اصلی آن جمع می‌کند
+func mergeISPStats(raw []models.DomainStat) map[string]models.DomainStat {
+	merged := make(map[string]models.DomainStat, len(raw))
+	for _, st := range raw {
+		key := ispDomain(st.Domain)
+		m := merged[key]
+		total := float64(m.Count + st.Count)
+		if total > 0 {
+			weigh := func(a, b float64) float64 { return (a*float64(m.Count) + b*float64(st.Count)) / total }
+			m.DeliveryRate = weigh(m.DeliveryRate, st.DeliveryRate)
+			m.OpenRate = weigh(m.OpenRate, st.OpenRate)
+			m.ClickRate = weigh(m.ClickRate, st.ClickRate)
+			m.BounceRate = weigh(m.BounceRate, st.BounceRate)
+			m.SpamReportRate = weigh(m.SpamReportRate, st.SpamReportRate)
+			m.DeferralRate = weigh(m.DeferralRate, st.DeferralRate)
+		}
+		m.Domain = key
+		m.Count += st.Count
+		merged[key] = m
+	}
+	return merged
+}
+
+func emailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(email[at+1:]))
+}
+
+// ispDomain دامنه اصلی ISP (مثلاً googlemail.com ← gmail.com)
+func ispDomain(d string) string {
+	if main, ok := ispDomains[d]; ok {
+		return main
+	}
+	return d
+}
+
+// throttleDelay زمان تعویق آیتم محدود شده؛ با Jitter تا آیتم‌های یک دامنه همزمان برنگردند
+func throttleDelay(wait time.Duration) time.Duration {
+	wait = max(wait, throttleMinDefer)
+	return wait + rand.N(wait)
+}
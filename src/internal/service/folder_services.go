@@ -0,0 +1,218 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type folderServices struct {
+	repo port.IFolderRepository
+}
+
+func NewFolderServices(repo port.IFolderRepository) port.IFolderService {
+	return &folderServices{repo: repo}
+}
+
+// CreateFolder: ساخت پوشه جدید (والد باید متعلق به همان اکانت باشد)
+func (s *folderServices) CreateFolder(ctx context.Context, f *models.MessageFolder) (*models.MessageFolder, error) {
+	if strings.TrimSpace(f.Name) == "" {
+		return nil, errors.New("folder name is required")
+	}
+	if !f.ParentID.IsZero() {
+		if _, err := s.repo.GetFolder(ctx, f.AccountID, f.ParentID); err != nil {
+			return nil, fmt.Errorf("parent folder not found: %w", err)
+		}
+	}
+
+	f.ID = primitive.NewObjectID()
+	f.CreatedAt = time.Now()
+	f.UpdatedAt = f.CreatedAt
+
+	if err := s.repo.CreateFolder(ctx, f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UpdateFolder: تغییر نام/توضیحات یا جابجایی پوشه در درخت؛ فیلدهای nil در u دست نمی‌خورند
+func (s *folderServices) UpdateFolder(ctx context.Context, accountID, folderID string, u domain.FolderUpdate) (*models.MessageFolder, error) {
+	id, err := parseFolderID(folderID)
+	if err != nil || id.IsZero() {
+		return nil, errors.New("invalid folder id")
+	}
+	f, err := s.repo.GetFolder(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Name != nil {
+		if strings.TrimSpace(*u.Name) == "" {
+			return nil, errors.New("folder name is required")
+		}
+		f.Name = *u.Name
+	}
+	if u.Description != nil {
+		f.Description = *u.Description
+	}
+
+	if u.ParentID != nil {
+		parentID, err := s.resolveTarget(ctx, accountID, *u.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		// جلوگیری از ایجاد حلقه: پوشه نمی‌تواند زیر خودش یا زیرپوشه‌هایش قرار بگیرد
+		if !parentID.IsZero() && parentID != f.ParentID {
+			subtree, err := s.repo.ListSubtreeIDs(ctx, accountID, f.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, sid := range subtree {
+				if sid == parentID {
+					return nil, errors.New("cannot move a folder into itself or one of its subfolders")
+				}
+			}
+		}
+		f.ParentID = parentID
+	}
+
+	f.UpdatedAt = time.Now()
+	if err := s.repo.UpdateFolder(ctx, f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (s *folderServices) GetFolderTree(ctx context.Context, accountID string) ([]*models.MessageFolderNode, error) {
+	folders, err := s.repo.ListFolders(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	return models.BuildFolderTree(folders), nil
+}
+
+// DeleteFolder: پوشه غیرخالی فقط با cascade حذف می‌شود (زیرپوشه‌ها، قالب‌ها و پیام‌ها هم حذف می‌شوند)
+func (s *folderServices) DeleteFolder(ctx context.Context, accountID, folderID string, cascade bool) error {
+	id, err := parseFolderID(folderID)
+	if err != nil || id.IsZero() {
+		return errors.New("invalid folder id")
+	}
+	if _, err := s.repo.GetFolder(ctx, accountID, id); err != nil {
+		return err
+	}
+
+	folders, templates, messages, err := s.repo.CountContents(ctx, accountID, id)
+	if err != nil {
+		return err
+	}
+	if !cascade && folders+templates+messages > 0 {
+		return fmt.Errorf("folder is not empty (%d folders, %d templates, %d messages); use cascade to delete it with its contents",
+			folders, templates, messages)
+	}
+
+	ids, err := s.repo.ListSubtreeIDs(ctx, accountID, id)
+	if err != nil {
+		return err
+	}
+	return s.repo.DeleteFolders(ctx, accountID, ids)
+}
+
+func (s *folderServices) MoveTemplate(ctx context.Context, accountID, templateID, folderID string) error {
+	id, err := s.resolveTarget(ctx, accountID, folderID)
+	if err != nil {
+		return err
+	}
+	return s.repo.MoveTemplate(ctx, accountID, templateID, id)
+}
+
+func (s *folderServices) MoveMessage(ctx context.Context, accountID, messageID, folderID string) error {
+	msgID, err := primitive.ObjectIDFromHex(messageID)
+	if err != nil {
+		return errors.New("invalid message id")
+	}
+	id, err := s.resolveTarget(ctx, accountID, folderID)
+	if err != nil {
+		return err
+	}
+	return s.repo.MoveMessage(ctx, accountID, msgID, id)
+}
+
+// ListFolderContents: محتوای پوشه؛ با recursive محتوای همه زیرپوشه‌ها هم برگردانده می‌شود
+func (s *folderServices) ListFolderContents(ctx context.Context, accountID, folderID string, recursive bool) (*domain.FolderContents, error) {
+	id, err := parseFolderID(folderID)
+	if err != nil {
+		return nil, errors.New("invalid folder id")
+	}
+
+	out := &domain.FolderContents{}
+	all, err := s.repo.ListFolders(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// ۱. مشخص کردن پوشه‌هایی که محتوایشان باید برگردانده شود
+	scope := []primitive.ObjectID{id}
+	if !id.IsZero() {
+		if out.Folder, err = s.repo.GetFolder(ctx, accountID, id); err != nil {
+			return nil, err
+		}
+		if recursive {
+			if scope, err = s.repo.ListSubtreeIDs(ctx, accountID, id); err != nil {
+				return nil, err
+			}
+		}
+	} else if recursive {
+		for _, f := range all {
+			scope = append(scope, f.ID)
+		}
+	}
+
+	inScope := make(map[primitive.ObjectID]bool, len(scope))
+	for _, fid := range scope {
+		inScope[fid] = true
+	}
+
+	// ۲. زیرپوشه‌ها (مستقیم یا کل زیردرخت)
+	for _, f := range all {
+		if f.ID != id && inScope[f.ParentID] {
+			out.Folders = append(out.Folders, f)
+		}
+	}
+
+	// ۳. قالب‌ها و پیام‌ها
+	if out.Templates, err = s.repo.ListTemplatesInFolders(ctx, accountID, scope); err != nil {
+		return nil, err
+	}
+	if out.Messages, err = s.repo.ListMessagesInFolders(ctx, accountID, scope); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// resolveTarget پوشه مقصد را اعتبارسنجی می‌کند؛ شناسه خالی یعنی ریشه
+func (s *folderServices) resolveTarget(ctx context.Context, accountID, folderID string) (primitive.ObjectID, error) {
+	id, err := parseFolderID(folderID)
+	if err != nil {
+		return id, errors.New("invalid folder id")
+	}
+	if !id.IsZero() {
+		if _, err := s.repo.GetFolder(ctx, accountID, id); err != nil {
+			return id, fmt.Errorf("target folder not found: %w", err)
+		}
+	}
+	return id, nil
+}
+
+func parseFolderID(folderID string) (primitive.ObjectID, error) {
+	if folderID == "" {
+		return primitive.NilObjectID, nil
+	}
+	return primitive.ObjectIDFromHex(folderID)
+}
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// کلیدهای AutomationSettings.TrackingSettings؛ رهگیری باز کردن و کلیک مبنای شرط‌های تعامل است و تا غیرفعال نشده روشن است
+const (
+	automationTrackOpensSetting  = "track_opens"
+	automationTrackClicksSetting = "track_clicks"
+	automationEcommerceSetting   = "ecommerce_tracking"
+)
+
+// handleJourney ایمیل مرحله یک Journey (آیتم صف automationEngine.enqueueEmail) را رندر و ارسال می‌کند
+func (s *campaignSender) handleJourney(ctx context.Context, item *models.QueueItem) (string, error) {
+	if s.automations == nil || s.messages == nil {
+		return "", fmt.Errorf("%w: journey emails are not supported by this sender", port.ErrPermanentFailure)
+	}
+	automationID, err1 := primitive.ObjectIDFromHex(toString(item.RecipientData[journeyAutomationVar]))
+	stepID, err2 := primitive.ObjectIDFromHex(toString(item.RecipientData[journeyStepVar]))
+	journeyID := toString(item.RecipientData[journeyIDVar])
+	if err1 != nil || err2 != nil {
+		return "", fmt.Errorf("%w: invalid journey queue item", port.ErrPermanentFailure)
+	}
+	a, err := s.automations.GetAutomation(ctx, item.AccountID, automationID)
+	if err != nil {
+		return "", fmt.Errorf("automation %s: %w", automationID.Hex(), err)
+	}
+	step := a.FindStep(stepID)
+	if step == nil {
+		return "", fmt.Errorf("%w: step %s was removed from automation", port.ErrPermanentFailure, stepID.Hex())
+	}
+
+	contact := make(map[string]string, len(item.RecipientData)+1)
+	for k, v := range item.RecipientData {
+		contact[k] = toString(v)
+	}
+	contact["email"] = item.RecipientEmail
+
+	subject, body, text, err := s.renderJourneyEmail(ctx, item.AccountID, step, contact)
+	if err != nil {
+		return "", err
+	}
+	if body, err = tagAutomationLinks(a, step, item.RecipientEmail, body); err != nil {
+		return "", err
+	}
+	opens, clicks := automationTracking(a)
+	if s.tracking != nil && (opens || clicks) {
+		token := domain.TrackingToken{
+			AccountID:  item.AccountID,
+			CampaignID: step.CampaignID,
+			Email:      item.RecipientEmail,
+			JourneyID:  journeyID,
+			StepID:     stepID.Hex(),
+		}
+		if body, err = s.tracking.Instrument(body, token, opens, clicks); err != nil {
+			return "", err
+		}
+	}
+
+	email := &domain.OutboundEmail{
+		AccountID: item.AccountID,
+		To:        item.RecipientEmail,
+		Subject:   subject,
+		HTML:      body,
+		Text:      text,
+		Metadata: map[string]string{
+			"automation_id": a.ID.Hex(),
+			"journey_id":    journeyID,
+			"queue_item_id": item.ID.Hex(),
+		},
+	}
+	if s.unsubscribe != nil {
+		// لغو عضویت برای کمپین متصل به مرحله یا در نبود آن برای خود اتوماسیون ثبت می‌شود
+		ref := step.CampaignID
+		if ref == "" {
+			ref = a.ID.Hex()
+		}
+		headers, err := s.unsubscribe.Headers(domain.UnsubscribeToken{
+			AccountID:  item.AccountID,
+			CampaignID: ref,
+			ListID:     contact[recipientListVar],
+			Email:      item.RecipientEmail,
+		})
+		if err != nil {
+			return "", err
+		}
+		email.Headers = headers
+	}
+	return s.mta.Send(ctx, email)
+}
+
+// renderJourneyEmail محتوای پیام مرحله (یا قالب متصل به آن) و در مراحل متصل به کمپین قالب پیش‌فرض کمپین
+func (s *campaignSender) renderJourneyEmail(ctx context.Context, accountID string, step *models.AutomationStep,
+	contact map[string]string) (subject, html, text string, err error) {
+	if step.MessageID.IsZero() && step.CampaignID != "" {
+		c, err := s.campaign(ctx, accountID, step.CampaignID)
+		if err != nil {
+			return "", "", "", err
+		}
+		templateID := campaignTemplateID(c)
+		if templateID == "" {
+			return "", "", "", fmt.Errorf("%w: campaign %s has no content", port.ErrPermanentFailure, step.CampaignID)
+		}
+		rendered, err := s.templates.RenderTemplate(ctx, accountID, templateID, contact)
+		if err != nil {
+			return "", "", "", err
+		}
+		subject = rendered.Subject
+		if step.Subject != "" {
+			subject = mergeTags(step.Subject, contact, false)
+		}
+		return subject, rendered.HTML, rendered.Text, nil
+	}
+
+	msg, err := s.messages.GetMessage(ctx, accountID, step.MessageID)
+	if err != nil {
+		return "", "", "", fmt.Errorf("message %s not found: %w", step.MessageID.Hex(), err)
+	}
+	subject = msg.Subject
+	if step.Subject != "" {
+		subject = step.Subject
+	}
+	html = mergeTags(msg.BodyHTML, contact, true)
+
+	if msg.TemplateID != "" {
+		rendered, err := s.templates.RenderTemplate(ctx, accountID, msg.TemplateID, contact)
+		if err != nil {
+			return "", "", "", err
+		}
+		html, text = rendered.HTML, rendered.Text
+		// موضوع قالب در رندر جایگذاری شده؛ جایگذاری دوباره مقادیر مخاطب شامل {{...}} را هم باز می‌کند
+		if step.Subject == "" {
+			return rendered.Subject, html, text, nil
+		}
+	}
+	return mergeTags(subject, contact, false), html, text, nil
+}
+
+// automationTracking رهگیری باز کردن و کلیک مگر اینکه در TrackingSettings خاموش شده باشد
+func automationTracking(a *models.EmailAutomation) (opens, clicks bool) {
+	opens, clicks = true, true
+	if v, ok := a.Settings.TrackingSettings[automationTrackOpensSetting]; ok {
+		opens = v
+	}
+	if v, ok := a.Settings.TrackingSettings[automationTrackClicksSetting]; ok {
+		clicks = v
+	}
+	return opens, clicks
+}
+
+// tagAutomationLinks برچسب UTM وقتی UTMParameters اتوماسیون تنظیم شده باشد (utm_campaign از نام اتوماسیون و utm_content
+// از نام مرحله) و شناسه‌های فروش وقتی TrackingSettings["ecommerce_tracking"] فعال باشد
+func tagAutomationLinks(a *models.EmailAutomation, step *models.AutomationStep, email, body string) (string, error) {
+	analytics := len(a.Settings.UTMParameters) > 0
+	ecommerce := a.Settings.TrackingSettings[automationEcommerceSetting]
+	if !analytics && !ecommerce {
+		return body, nil
+	}
+	variation := step.Name
+	if variation == "" {
+		variation = step.ID.Hex()
+	}
+	tags := utmTags(a.Name, variation, a.Settings.UTMParameters)
+	if !analytics {
+		tags.Params = nil
+	}
+	if ecommerce {
+		tags.Ecommerce = ecommerceIDs(a.ID.Hex(), email)
+	}
+	return rewriteLinks(body, func(href string) string { return tagURL(href, tags) }, nil)
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	xhtml "golang.org/x/net/html"
+)
+
+// rewriteLinks href لینک‌های http(s) را با link جایگزین و در صورت وجود tail آن را به انتهای body اضافه می‌کند
+//
+// link مقدار href را بدون Escape دریافت می‌کند؛ برگرداندن همان مقدار یعنی لینک بدون تغییر می‌ماند.
+func rewriteLinks(content string, link func(href string) string, tail *xhtml.Node) (string, error) {
+	doc, err := xhtml.Parse(strings.NewReader(content))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse email html: %w", err)
+	}
+
+	var body *xhtml.Node
+	walkNodes(doc, func(n *xhtml.Node) bool {
+		if n.Type != xhtml.ElementNode {
+			return true
+		}
+		switch n.Data {
+		case "body":
+			body = n
+		case "a", "area":
+			if link == nil {
+				break
+			}
+			href := strings.TrimSpace(attr(n, "href"))
+			lower := strings.ToLower(href)
+			if strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") {
+				setAttr(n, "href", link(href))
+			}
+		}
+		return true
+	})
+	if tail != nil && body != nil {
+		body.AppendChild(tail)
+	}
+
+	var sb strings.Builder
+	if err := xhtml.Render(&sb, doc); err != nil {
+		return "", fmt.Errorf("failed to render email html: %w", err)
+	}
+	return sb.String(), nil
+}
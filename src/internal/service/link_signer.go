@@ -0,0 +1,60 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+)
+
+// linkSigner توکن‌های لینک‌های عمومی: base64url(JSON) + "." + base64url(HMAC-SHA256)
+//
+// purpose در امضا وارد می‌شود تا توکن یک نوع لینک (مثلاً رهگیری) برای نوع دیگر (لغو عضویت) قابل استفاده نباشد.
+type linkSigner struct {
+	key []byte
+}
+
+func newLinkSigner(key string) (*linkSigner, error) {
+	if len(key) < 16 {
+		return nil, errors.New("link signing key must be at least 16 characters")
+	}
+	return &linkSigner{key: []byte(key)}, nil
+}
+
+func (s *linkSigner) sign(purpose string, v any) (string, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	enc := base64.RawURLEncoding.EncodeToString(payload)
+	return enc + "." + base64.RawURLEncoding.EncodeToString(s.mac(purpose, enc)), nil
+}
+
+// verify در صورت نامعتبر بودن امضا port.ErrInvalidToken برمی‌گرداند
+func (s *linkSigner) verify(purpose, token string, v any) error {
+	enc, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return port.ErrInvalidToken
+	}
+	got, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(got, s.mac(purpose, enc)) {
+		return port.ErrInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(enc)
+	if err != nil || json.Unmarshal(payload, v) != nil {
+		return port.ErrInvalidToken
+	}
+	return nil
+}
+
+func (s *linkSigner) mac(purpose, payload string) []byte {
+	m := hmac.New(sha256.New, s.key)
+	m.Write([]byte(purpose))
+	m.Write([]byte{0})
+	m.Write([]byte(payload))
+	return m.Sum(nil)
+}
@@ -0,0 +1,270 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	models "github.com/ehsanshah/campaign-services/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	queueVisibility     = 5 * time.Minute // آیتمی که در این مدت تمام نشود به Worker دیگری داده می‌شود
+	queueHandlerSlack   = 30 * time.Second
+	queuePollEvery      = 2 * time.Second
+	queueLeasePerWorker = 2
+	defaultMaxRetries   = 5
+	queueBackoffBase    = 30 * time.Second
+	queueBackoffMax     = time.Hour
+	// آیتمی که Suppression آن قابل بررسی نیست ارسال نمی‌شود و بعد از این مدت دوباره بررسی می‌شود
+	suppressionCheckRetry = time.Minute
+)
+
+type sendQueue struct {
+	repo        port.IQueueRepository
+	throttle    port.IDomainThrottle     // اختیاری؛ محدودیت نرخ به تفکیک دامنه گیرنده
+	suppression port.ISuppressionService // اختیاری؛ گیرندگان Suppress شده پیش از ارسال رد می‌شوند
+	workerID    string
+}
+
+// workerID باید برای هر نمونه سرویس یکتا باشد (مثلاً hostname-pid)
+func NewSendQueue(repo port.IQueueRepository, throttle port.IDomainThrottle, suppression port.ISuppressionService, workerID string) port.ISendQueue {
+	return &sendQueue{repo: repo, throttle: throttle, suppression: suppression, workerID: workerID}
+}
+
+func (q *sendQueue) Enqueue(ctx context.Context, accountID string, campaignID primitive.ObjectID, items []*models.QueueItem) (*models.QueueBatch, error) {
+	batch, err := newQueueBatch(accountID, campaignID, items, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := q.repo.CreateBatch(ctx, batch, items); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+func (q *sendQueue) GetBatch(ctx context.Context, accountID, batchID string) (*models.QueueBatch, error) {
+	id, err := primitive.ObjectIDFromHex(batchID)
+	if err != nil {
+		return nil, errors.New("invalid batch id")
+	}
+	return q.repo.GetBatch(ctx, accountID, id)
+}
+
+func (q *sendQueue) PendingItems(ctx context.Context, accountID, campaignRef string) (int, error) {
+	return q.repo.PendingItems(ctx, accountID, campaignRef)
+}
+
+// newQueueBatch دسته جدید و مقداردهی آیتم‌ها؛ مقادیر خالی Priority، MaxRetries و ScheduledAt با پیش‌فرض‌ها پر می‌شوند
+func newQueueBatch(accountID string, campaignID primitive.ObjectID, items []*models.QueueItem, now time.Time) (*models.QueueBatch, error) {
+	if accountID == "" {
+		return nil, errors.New("account id is required")
+	}
+	if len(items) == 0 {
+		return nil, errors.New("no items to enqueue")
+	}
+
+	batch := &models.QueueBatch{
+		ID:         primitive.NewObjectID(),
+		AccountID:  accountID,
+		CampaignID: campaignID,
+		Status:     models.QUEUE_PENDING,
+		CreatedAt:  now,
+	}
+	for _, it := range items {
+		it.RecipientEmail = strings.ToLower(strings.TrimSpace(it.RecipientEmail))
+		if it.RecipientEmail == "" {
+			return nil, errors.New("queue item without recipient email")
+		}
+		it.ID = primitive.NewObjectID()
+		it.BatchID = batch.ID
+		it.AccountID = accountID
+		it.CampaignID = campaignID
+		it.Status = models.QUEUE_PENDING
+		it.CreatedAt = now
+		it.RetryCount = 0
+		if it.Priority == 0 {
+			it.Priority = models.PRIORITY_NORMAL
+		}
+		if it.MaxRetries <= 0 {
+			it.MaxRetries = defaultMaxRetries
+		}
+		if it.ScheduledAt.IsZero() {
+			it.ScheduledAt = now
+		}
+	}
+	return batch, nil
+}
+
+// Run: یک حلقه آیتم‌ها را Lease می‌کند و workers گوروتین آن‌ها را پردازش می‌کنند
+func (q *sendQueue) Run(ctx context.Context, handler port.QueueHandler, workers int) error {
+	if workers <= 0 {
+		workers = 1
+	}
+	jobs := make(chan *models.QueueItem)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range jobs {
+				q.process(ctx, handler, item)
+			}
+		}()
+	}
+	defer func() {
+		close(jobs)
+		wg.Wait()
+	}()
+
+	for {
+		items, err := q.repo.LeaseItems(ctx, q.workerID, time.Now(), workers*queueLeasePerWorker, queueVisibility)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("⚠️ send queue: failed to lease items: %v", err)
+		}
+		// آیتم‌های Lease شده‌ای که بعد از لغو ctx پردازش نشوند بعد از انقضای Lease دوباره برداشته می‌شوند
+		for _, item := range items {
+			select {
+			case jobs <- item:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if len(items) == 0 || err != nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(queuePollEvery):
+			}
+		}
+	}
+}
+
+// process نتیجه Handler را ثبت می‌کند: موفق، تلاش مجدد با Backoff یا شکست نهایی
+func (q *sendQueue) process(ctx context.Context, handler port.QueueHandler, item *models.QueueItem) {
+	// Lease منقضی هم یک تلاش حساب شده است؛ آیتمی که سقف تلاش را پر کرده بدون فراخوانی Handler به Dead Letter می‌رود
+	if item.RetryCount > item.MaxRetries {
+		if err := q.repo.FailItem(context.WithoutCancel(ctx), item.ID, q.workerID, item.LastError, models.ERROR_CLASS_LEASE_EXPIRED, time.Now()); err != nil {
+			log.Printf("⚠️ send queue item %s: %v", item.ID.Hex(), err)
+		}
+		return
+	}
+	if q.suppressed(ctx, item) || q.throttled(ctx, item) {
+		return
+	}
+
+	hctx, cancel := context.WithTimeout(ctx, queueVisibility-queueHandlerSlack)
+	externalID, err := handler(hctx, item)
+	cancel()
+
+	// ثبت نتیجه حتی بعد از لغو ctx انجام می‌شود تا آیتم ارسال شده دوباره ارسال نشود
+	fctx := context.WithoutCancel(ctx)
+	now := time.Now()
+	var saveErr error
+	switch {
+	case err == nil:
+		saveErr = q.repo.CompleteItem(fctx, item.ID, q.workerID, externalID, now)
+	case errors.Is(err, port.ErrPermanentFailure) || item.RetryCount >= item.MaxRetries:
+		saveErr = q.repo.FailItem(fctx, item.ID, q.workerID, err.Error(), classifyQueueError(err), now)
+	default:
+		saveErr = q.repo.RetryItem(fctx, item.ID, q.workerID, err.Error(), now.Add(queueBackoff(item.RetryCount)))
+	}
+	if saveErr != nil {
+		log.Printf("⚠️ send queue item %s: %v", item.ID.Hex(), saveErr)
+	}
+}
+
+// suppressed گیرنده Suppress شده رد می‌شود؛ اگر بررسی ممکن نباشد آیتم بدون مصرف تلاش به تعویق می‌افتد
+func (q *sendQueue) suppressed(ctx context.Context, item *models.QueueItem) bool {
+	if q.suppression == nil {
+		return false
+	}
+	now := time.Now()
+	fctx := context.WithoutCancel(ctx)
+	reason, err := q.suppression.Check(ctx, item.AccountID, item.RecipientEmail)
+	if err != nil {
+		log.Printf("⚠️ send queue: suppression check: %v", err)
+		if err := q.repo.DeferItem(fctx, item.ID, q.workerID, now.Add(suppressionCheckRetry)); err != nil {
+			log.Printf("⚠️ send queue item %s: %v", item.ID.Hex(), err)
+		}
+		return true
+	}
+	if reason == "" {
+		return false
+	}
+	if err := q.repo.SkipItem(fctx, item.ID, q.workerID, reason, now); err != nil {
+		log.Printf("⚠️ send queue item %s: %v", item.ID.Hex(), err)
+	}
+	return true
+}
+
+// throttled اگر دامنه گیرنده به محدودیت نرخ رسیده باشد آیتم را بدون مصرف تلاش به تعویق می‌اندازد
+func (q *sendQueue) throttled(ctx context.Context, item *models.QueueItem) bool {
+	if q.throttle == nil {
+		return false
+	}
+	now := time.Now()
+	wait, err := q.throttle.Reserve(ctx, item.AccountID, item.SendingDomain, item.RecipientEmail, now)
+	if err != nil {
+		// در دسترس نبودن قوانین نباید ارسال را متوقف کند
+		log.Printf("⚠️ send queue: domain throttle: %v", err)
+		return false
+	}
+	if wait <= 0 {
+		return false
+	}
+	if err := q.repo.DeferItem(context.WithoutCancel(ctx), item.ID, q.workerID, now.Add(throttleDelay(wait))); err != nil {
+		log.Printf("⚠️ send queue item %s: %v", item.ID.Hex(), err)
+	}
+	return true
+}
+
+// classifyQueueError کلاس خطای آخرین تلاش برای گروه‌بندی در صف مرده
+func classifyQueueError(err error) string {
+	msg := strings.ToLower(err.Error())
+	var netErr net.Error
+	switch {
+	case containsAny(msg, "rejected", "invalid recipient", "user unknown", "mailbox unavailable", "blocked", "550 ", "553 ", "554 "):
+		return models.ERROR_CLASS_REJECTED
+	case errors.Is(err, port.ErrPermanentFailure):
+		return models.ERROR_CLASS_PERMANENT
+	case status.Code(err) == codes.ResourceExhausted || containsAny(msg, "rate limit", "too many", "throttl"):
+		return models.ERROR_CLASS_RATE_LIMITED
+	case errors.Is(err, context.DeadlineExceeded) || status.Code(err) == codes.DeadlineExceeded ||
+		(errors.As(err, &netErr) && netErr.Timeout()) || containsAny(msg, "timeout", "timed out"):
+		return models.ERROR_CLASS_TIMEOUT
+	case status.Code(err) == codes.Unavailable || containsAny(msg, "connection refused", "connection reset", "no such host", "unavailable"):
+		return models.ERROR_CLASS_CONNECTION
+	}
+	return models.ERROR_CLASS_UNKNOWN
+}
+
+func containsAny(s string, parts ...string) bool {
+	for _, p := range parts {
+		if strings.Contains(s, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// queueBackoff فاصله تلاش مجدد: 30s، 1m، 2m، ... تا حداکثر یک ساعت
+func queueBackoff(retryCount int) time.Duration {
+	d := queueBackoffBase
+	for i := 0; i < retryCount; i++ {
+		d *= 2
+		if d >= queueBackoffMax {
+			return queueBackoffMax
+		}
+	}
+	return d
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+)
+
+const (
+	suppressionCacheTTL      = 10 * time.Minute
+	suppressionRetryAfter    = time.Minute // فاصله تلاش دوباره وقتی بارگذاری فهرست شکست خورده و نسخه قبلی استفاده می‌شود
+	globalSuppressionRefresh = time.Minute
+	// اکانتی که فهرست بزرگ‌تری دارد در حافظه نگه داشته نمی‌شود و هر گیرنده با GetSuppression بررسی می‌شود
+	maxCachedSuppressions = 2_000_000
+)
+
+// accountSuppressions فهرست Suppression یک اکانت در حافظه
+type accountSuppressions struct {
+	mu       sync.RWMutex
+	loadedAt time.Time
+	emails   map[string]string // ایمیل ← دلیل؛ nil یعنی هنوز بارگذاری نشده
+	tooLarge bool
+}
+
+type suppressionService struct {
+	client port.ISuppressionClient
+	repo   port.ISuppressionRepository
+
+	mu          sync.Mutex
+	accounts    map[string]*accountSuppressions
+	global      map[string]string // Bounce ها و Complaint های سراسری
+	globalAt    time.Time         // زمان آخرین بارگذاری global
+	globalSince time.Time         // جدیدترین created_at خوانده شده؛ بارگذاری‌های بعدی فقط موارد جدید را می‌خوانند
+}
+
+func NewSuppressionService(client port.ISuppressionClient, repo port.ISuppressionRepository) port.ISuppressionService {
+	return &suppressionService{client: client, repo: repo, accounts: make(map[string]*accountSuppressions)}
+}
+
+// Check اگر فهرست هنوز بارگذاری نشده باشد و بارگذاری شکست بخورد خطا برمی‌گردد تا آیتم بدون بررسی ارسال نشود
+func (s *suppressionService) Check(ctx context.Context, accountID, email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if reason, err := s.checkGlobal(ctx, email); err != nil || reason != "" {
+		return reason, err
+	}
+
+	a := s.account(accountID)
+	a.mu.RLock()
+	fresh := a.emails != nil && time.Since(a.loadedAt) < suppressionCacheTTL
+	reason, tooLarge := a.emails[email], a.tooLarge
+	a.mu.RUnlock()
+
+	if !fresh {
+		a.mu.Lock()
+		if a.emails == nil || time.Since(a.loadedAt) >= suppressionCacheTTL {
+			if err := s.load(ctx, accountID, a); err != nil {
+				if a.emails == nil {
+					a.mu.Unlock()
+					return "", err
+				}
+				log.Printf("⚠️ suppression list of account %s: %v (using cached list)", accountID, err)
+				a.loadedAt = time.Now().Add(suppressionRetryAfter - suppressionCacheTTL)
+			}
+		}
+		reason, tooLarge = a.emails[email], a.tooLarge
+		a.mu.Unlock()
+	}
+
+	if tooLarge {
+		sup, err := s.client.GetSuppression(ctx, accountID, email)
+		if err != nil || sup == nil {
+			return "", err
+		}
+		return suppressionReason(sup.Reason), nil
+	}
+	return reason, nil
+}
+
+func (s *suppressionService) Suppressed(accountID, email, reason string) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	a := s.account(accountID)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.emails != nil && !a.tooLarge {
+		a.emails[email] = suppressionReason(reason)
+	}
+}
+
+func (s *suppressionService) SummarizeCampaign(ctx context.Context, accountID, campaignID string) ([]domain.SuppressionSummary, error) {
+	return s.repo.SummarizeCampaignSuppressions(ctx, accountID, campaignID)
+}
+
+// ---------------------------------------------------------
+// توابع کمکی
+// ---------------------------------------------------------
+
+func (s *suppressionService) account(accountID string) *accountSuppressions {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a, ok := s.accounts[accountID]
+	if !ok {
+		a = &accountSuppressions{}
+		s.accounts[accountID] = a
+	}
+	return a
+}
+
+// load همه صفحه‌های فهرست اکانت (قفل نوشتن a باید گرفته شده باشد)
+func (s *suppressionService) load(ctx context.Context, accountID string, a *accountSuppressions) error {
+	emails := make(map[string]string)
+	var pageToken []byte
+	for {
+		page, next, err := s.client.ListSuppressions(ctx, accountID, pageToken)
+		if err != nil {
+			return fmt.Errorf("list suppressions: %w", err)
+		}
+		for _, sup := range page {
+			emails[strings.ToLower(sup.Email)] = suppressionReason(sup.Reason)
+		}
+		if len(emails) > maxCachedSuppressions {
+			a.emails, a.tooLarge, a.loadedAt = map[string]string{}, true, time.Now()
+			return nil
+		}
+		if len(next) == 0 {
+			break
+		}
+		pageToken = next
+	}
+	a.emails, a.tooLarge, a.loadedAt = emails, false, time.Now()
+	return nil
+}
+
+func (s *suppressionService) checkGlobal(ctx context.Context, email string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if time.Since(s.globalAt) >= globalSuppressionRefresh {
+		items, err := s.repo.ListGlobalSuppressions(ctx, s.globalSince)
+		switch {
+		case err != nil && s.global == nil:
+			return "", fmt.Errorf("list global suppressions: %w", err)
+		case err != nil:
+			log.Printf("⚠️ global suppressions: %v (using cached list)", err)
+		default:
+			if s.global == nil {
+				s.global = make(map[string]string, len(items))
+			}
+			for _, sup := range items {
+				s.global[strings.ToLower(sup.Email)] = suppressionReason(sup.Reason)
+				if sup.CreatedAt.After(s.globalSince) {
+					s.globalSince = sup.CreatedAt
+				}
+			}
+		}
+		s.globalAt = time.Now()
+	}
+	return s.global[email], nil
+}
+
+// suppressionReason دلیل خالی (رکوردهای قدیمی Audience) به عنوان unsubscribe ثبت می‌شود
+func suppressionReason(reason string) string {
+	if reason = strings.ToLower(strings.TrimSpace(reason)); reason == "" {
+		return domain.SuppressionUnsubscribe
+	}
+	return reason
+}
@@ -0,0 +1,260 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"golang.org/x/net/html"
+)
+
+// حداقل تعداد کاراکتر متن به ازای هر تصویر؛ کمتر از این یعنی ایمیل «تصویر-محور» است
+const minTextCharsPerImage = 200
+
+// بررسی لینک‌ها: حداکثر تعداد لینک یکتا و تعداد بررسی همزمان
+const (
+	maxResolvedLinks   = 50
+	linkResolveWorkers = 8
+)
+
+// عبارات رایج که فیلترهای اسپم به آن‌ها حساس هستند
+var spamPhrases = []string{
+	"100% free", "act now", "buy now", "cash bonus", "click here", "double your",
+	"earn money", "free gift", "free money", "guaranteed", "limited time offer",
+	"make money fast", "no credit check", "no obligation", "risk free", "risk-free",
+	"urgent", "winner", "you have been selected", "million dollars", "$$$",
+	"کسب درآمد فوری", "کاملا رایگان", "برنده شدید", "فرصت محدود", "بدون ریسک",
+}
+
+// الگوهای قابل قبول برای لینک یا Merge Tag لغو اشتراک
+var unsubscribeRe = regexp.MustCompile(`(?i)(unsubscribe|opt[-_ ]?out|\*\|unsub\|\*|لغو\s*اشتراک|لغو\s*عضویت)`)
+
+// تگ‌هایی که بسته شدنشان اختیاری است و در بررسی توازن نادیده گرفته می‌شوند
+var optionalCloseTags = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+	"input": true, "link": true, "meta": true, "source": true, "track": true, "wbr": true,
+	"p": true, "li": true, "td": true, "th": true, "tr": true, "tbody": true, "thead": true,
+	"tfoot": true, "option": true, "dt": true, "dd": true, "colgroup": true,
+}
+
+type templateLinter struct {
+	resolver port.ILinkResolver // اختیاری؛ nil یعنی لینک‌ها بررسی نمی‌شوند
+}
+
+func NewTemplateLinter(resolver port.ILinkResolver) port.ITemplateLinter {
+	return &templateLinter{resolver: resolver}
+}
+
+func (l *templateLinter) Lint(ctx context.Context, v *domain.TemplateVersion) *domain.TemplateLintResult {
+	res := &domain.TemplateLintResult{
+		VersionID: v.ID,
+		Passed:    true,
+		CheckedAt: time.Now(),
+	}
+
+	l.checkSubject(v.Subject, res)
+	l.checkBalance(v.HTMLContent, res)
+
+	doc, err := html.Parse(strings.NewReader(v.HTMLContent))
+	if err != nil {
+		res.Add(domain.LintRuleUnbalancedHTML, domain.LintSeverityError, "html could not be parsed: "+err.Error(), "body")
+		return res
+	}
+
+	var links []string
+	var images int
+	hasUnsubscribe := unsubscribeRe.MatchString(v.PlainText)
+
+	walkNodes(doc, func(n *html.Node) bool {
+		if n.Type != html.ElementNode {
+			return true
+		}
+		switch n.Data {
+		case "img":
+			images++
+			if _, ok := attrValue(n, "alt"); !ok {
+				res.Add(domain.LintRuleImageAlt, domain.LintSeverityWarning, "image has no alt text", attr(n, "src"))
+			}
+		case "a":
+			href := strings.TrimSpace(attr(n, "href"))
+			if unsubscribeRe.MatchString(href) || unsubscribeRe.MatchString(nodeText(n)) {
+				hasUnsubscribe = true
+			}
+			if strings.HasPrefix(href, "http://") || strings.HasPrefix(href, "https://") {
+				links = append(links, href)
+			}
+		}
+		return true
+	})
+
+	// ۱. لینک لغو اشتراک (الزام قانونی و شرط Gmail/Yahoo برای ارسال انبوه)
+	if !hasUnsubscribe && !unsubscribeRe.MatchString(v.HTMLContent) {
+		res.Add(domain.LintRuleMissingUnsubscribe, domain.LintSeverityError, "no unsubscribe link or merge tag found", "body")
+	}
+
+	// ۲. نسبت تصویر به متن
+	text := strings.TrimSpace(spaceRe.ReplaceAllString(nodeText(doc), " "))
+	textChars := len([]rune(text))
+	if images > 0 && textChars < images*minTextCharsPerImage {
+		res.Add(domain.LintRuleImageTextRatio, domain.LintSeverityWarning,
+			fmt.Sprintf("%d image(s) with only %d characters of text; image-heavy emails are often filtered as spam", images, textChars), "body")
+	}
+
+	// ۳. کلمات حساس اسپم در متن
+	lowerBody := strings.ToLower(text)
+	for _, phrase := range spamPhrases {
+		if strings.Contains(lowerBody, phrase) {
+			res.Add(domain.LintRuleSpamPhrase, domain.LintSeverityWarning, fmt.Sprintf("body contains spam-trigger phrase %q", phrase), "body")
+		}
+	}
+
+	// ۴. لینک‌های خراب (فقط اگر Resolver تنظیم شده باشد)
+	if l.resolver != nil {
+		l.checkLinks(ctx, links, res)
+	}
+
+	return res
+}
+
+// checkLinks لینک‌های یکتا را همزمان بررسی می‌کند؛ یافته‌ها به ترتیب لینک‌ها اضافه می‌شوند
+func (l *templateLinter) checkLinks(ctx context.Context, links []string, res *domain.TemplateLintResult) {
+	seen := make(map[string]bool)
+	var unique []string
+	for _, link := range links {
+		// لینک‌های دارای Merge Tag هنگام رندر ساخته می‌شوند و قابل بررسی نیستند
+		if seen[link] || strings.Contains(link, "{{") || strings.Contains(link, "*|") {
+			continue
+		}
+		seen[link] = true
+		unique = append(unique, link)
+	}
+	if len(unique) > maxResolvedLinks {
+		res.Add(domain.LintRuleBrokenLink, domain.LintSeverityWarning,
+			fmt.Sprintf("only the first %d of %d links were checked", maxResolvedLinks, len(unique)), "body")
+		unique = unique[:maxResolvedLinks]
+	}
+
+	errs := make([]error, len(unique))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(linkResolveWorkers, len(unique)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				errs[i] = l.resolver.Resolve(ctx, unique[i])
+			}
+		}()
+	}
+	for i := range unique {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+
+	// خطای سایت‌های دیگر ممکن است موقتی باشد؛ فقط هشدار و مانع زمان‌بندی نمی‌شود
+	for i, err := range errs {
+		if err != nil {
+			res.Add(domain.LintRuleBrokenLink, domain.LintSeverityWarning, "link is unreachable: "+err.Error(), unique[i])
+		}
+	}
+}
+
+func (l *templateLinter) checkSubject(subject string, res *domain.TemplateLintResult) {
+	lower := strings.ToLower(subject)
+	for _, phrase := range spamPhrases {
+		if strings.Contains(lower, phrase) {
+			res.Add(domain.LintRuleSpamPhrase, domain.LintSeverityWarning, fmt.Sprintf("subject contains spam-trigger phrase %q", phrase), "subject")
+		}
+	}
+
+	letters, upper := 0, 0
+	for _, r := range subject {
+		if unicode.IsLetter(r) && unicode.IsUpper(r) != unicode.IsLower(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+	if letters >= 5 && upper == letters {
+		res.Add(domain.LintRuleAllCapsSubject, domain.LintSeverityWarning, "subject is written in all caps", "subject")
+	}
+}
+
+// checkBalance تگ‌های باز و بسته را با Tokenizer بررسی می‌کند (Parser خطاها را پنهان می‌کند)
+func (l *templateLinter) checkBalance(content string, res *domain.TemplateLintResult) {
+	z := html.NewTokenizer(strings.NewReader(content))
+	var stack []string
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			if z.Err() != io.EOF {
+				res.Add(domain.LintRuleUnbalancedHTML, domain.LintSeverityError, "html tokenizer error: "+z.Err().Error(), "body")
+			}
+			break
+		}
+		name, _ := z.TagName()
+		tag := string(name)
+		switch tt {
+		case html.StartTagToken:
+			if !optionalCloseTags[tag] {
+				stack = append(stack, tag)
+			}
+		case html.EndTagToken:
+			if optionalCloseTags[tag] {
+				continue
+			}
+			idx := -1
+			for i := len(stack) - 1; i >= 0; i-- {
+				if stack[i] == tag {
+					idx = i
+					break
+				}
+			}
+			if idx < 0 {
+				res.Add(domain.LintRuleUnbalancedHTML, domain.LintSeverityWarning, fmt.Sprintf("closing tag </%s> has no matching opening tag", tag), "body")
+				continue
+			}
+			for _, open := range stack[idx+1:] {
+				res.Add(domain.LintRuleUnbalancedHTML, domain.LintSeverityWarning, fmt.Sprintf("tag <%s> is not closed", open), "body")
+			}
+			stack = stack[:idx]
+		}
+	}
+	for _, open := range stack {
+		res.Add(domain.LintRuleUnbalancedHTML, domain.LintSeverityWarning, fmt.Sprintf("tag <%s> is not closed", open), "body")
+	}
+}
+
+// nodeText متن قابل مشاهده یک نود و فرزندانش را برمی‌گرداند
+func nodeText(n *html.Node) string {
+	var sb strings.Builder
+	walkNodes(n, func(c *html.Node) bool {
+		if c.Type == html.ElementNode && (c.Data == "script" || c.Data == "style" || c.Data == "head") {
+			return false
+		}
+		if c.Type == html.TextNode {
+			sb.WriteString(c.Data)
+			sb.WriteString(" ")
+		}
+		return true
+	})
+	return sb.String()
+}
+
+func attrValue(n *html.Node, key string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,646 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// templateProcessor پایپ‌لاین پردازش HTML قبل از ذخیره نسخه قالب
+// ترتیب اجرا: Inline CSS -> ساخت نسخه متنی -> Minify -> بررسی حجم
+type templateProcessor struct {
+	opts domain.TemplateProcessingOptions
+}
+
+func NewTemplateProcessor(opts domain.TemplateProcessingOptions) port.ITemplateProcessor {
+	if opts.SizeWarningBytes <= 0 {
+		opts.SizeWarningBytes = domain.GmailClipThreshold
+	}
+	return &templateProcessor{opts: opts}
+}
+
+func (p *templateProcessor) Process(ctx context.Context, v *domain.TemplateVersion) error {
+	if strings.TrimSpace(v.HTMLContent) == "" {
+		return nil
+	}
+
+	// اکشن‌های قالب ({{...}}) قبل از Parse کنار گذاشته می‌شوند تا Render نقل‌قول‌های داخلشان را Escape نکند
+	content, actions := protectActions(v.HTMLContent)
+	doc, err := parseTemplateHTML(content)
+	if err != nil {
+		return fmt.Errorf("failed to parse template html: %w", err)
+	}
+
+	// ۱. انتقال CSS به attribute های style (بسیاری از کلاینت‌ها <style> را حذف می‌کنند)
+	if p.opts.InlineCSS {
+		inlineCSS(doc)
+	}
+
+	// ۲. نسخه متنی فقط وقتی ساخته می‌شود که کاربر خودش آن را نفرستاده باشد
+	if p.opts.GeneratePlainText && strings.TrimSpace(v.PlainText) == "" {
+		v.PlainText = restoreActions(htmlToPlainText(doc), actions)
+	}
+
+	// ۳. حذف فاصله‌ها و کامنت‌های اضافه
+	if p.opts.Minify {
+		minifyNode(doc)
+	}
+
+	if p.opts.InlineCSS || p.opts.Minify {
+		var sb strings.Builder
+		if err := html.Render(&sb, doc); err != nil {
+			return fmt.Errorf("failed to render template html: %w", err)
+		}
+		v.HTMLContent = restoreActions(sb.String(), actions)
+	}
+
+	// ۴. هشدار حجم (Gmail بیشتر از ~102KB را کوتاه می‌کند)
+	if size := len(v.HTMLContent); size > p.opts.SizeWarningBytes {
+		v.Warnings = append(v.Warnings, fmt.Sprintf(
+			"html size is %d KB, which exceeds the %d KB clipping threshold of Gmail",
+			size/1024, p.opts.SizeWarningBytes/1024))
+	}
+
+	return nil
+}
+
+// ---------------------------------------------------------
+// اکشن‌های قالب و بخش‌های HTML
+// ---------------------------------------------------------
+
+var (
+	templateActionRe = regexp.MustCompile(`(?s)\{\{.*?\}\}`)
+	// placeholder اگر جای نام attribute آمده باشد (<td {{if .x}}...) با مقدار خالی Render می‌شود
+	actionPlaceholderRe = regexp.MustCompile(`__tpl_action_(\d+)__(?:="")?`)
+	fullDocumentRe      = regexp.MustCompile(`(?i)<(!doctype|html|head|body)[\s>]`)
+)
+
+// protectActions هر {{...}} را با یک placeholder که Parse و Render آن را تغییر نمی‌دهند جایگزین می‌کند
+func protectActions(content string) (string, []string) {
+	var actions []string
+	out := templateActionRe.ReplaceAllStringFunc(content, func(action string) string {
+		actions = append(actions, action)
+		return fmt.Sprintf("__tpl_action_%d__", len(actions)-1)
+	})
+	return out, actions
+}
+
+func restoreActions(content string, actions []string) string {
+	if len(actions) == 0 {
+		return content
+	}
+	return actionPlaceholderRe.ReplaceAllStringFunc(content, func(m string) string {
+		i, err := strconv.Atoi(actionPlaceholderRe.FindStringSubmatch(m)[1])
+		if err != nil || i >= len(actions) {
+			return m
+		}
+		return actions[i]
+	})
+}
+
+// parseTemplateHTML بخش‌های HTML (بدون html/body) به صورت Fragment خوانده می‌شوند تا Render آن‌ها را در html/head/body نپیچد
+func parseTemplateHTML(content string) (*html.Node, error) {
+	if fullDocumentRe.MatchString(content) {
+		return html.Parse(strings.NewReader(content))
+	}
+	nodes, err := html.ParseFragment(strings.NewReader(content), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+	if err != nil {
+		return nil, err
+	}
+	doc := &html.Node{Type: html.DocumentNode}
+	for _, n := range nodes {
+		doc.AppendChild(n)
+	}
+	return doc, nil
+}
+
+// ---------------------------------------------------------
+// Inline CSS
+// ---------------------------------------------------------
+
+type cssDeclaration struct {
+	Property  string
+	Value     string
+	Important bool
+}
+
+type cssRule struct {
+	Selector    []cssCompound
+	Specificity [3]int
+	Order       int
+	Decls       []cssDeclaration
+}
+
+// cssCompound یک بخش از سلکتور (مثل div.header) به همراه نوع اتصال به بخش قبلی
+type cssCompound struct {
+	Tag        string
+	ID         string
+	Classes    []string
+	Combinator byte // ' ' برای descendant و '>' برای child
+}
+
+var (
+	cssCommentRe  = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	cssCompoundRe = regexp.MustCompile(`^([a-zA-Z][a-zA-Z0-9-]*|\*)?((?:[.#][A-Za-z0-9_-]+)*)$`)
+	cssPartRe     = regexp.MustCompile(`[.#][A-Za-z0-9_-]+`)
+	spaceRe       = regexp.MustCompile(`\s+`)
+)
+
+func inlineCSS(doc *html.Node) {
+	var styleNodes []*html.Node
+	var sheets []string
+	walkNodes(doc, func(n *html.Node) bool {
+		if n.Type == html.ElementNode && n.Data == "style" {
+			styleNodes = append(styleNodes, n)
+			if n.FirstChild != nil {
+				sheets = append(sheets, n.FirstChild.Data)
+			}
+			return false
+		}
+		return true
+	})
+	if len(styleNodes) == 0 {
+		return
+	}
+
+	rules, residual := parseStylesheet(strings.Join(sheets, "\n"))
+
+	walkNodes(doc, func(n *html.Node) bool {
+		if n.Type != html.ElementNode {
+			return true
+		}
+		var matched []cssRule
+		for _, r := range rules {
+			if matchSelector(n, r.Selector) {
+				matched = append(matched, r)
+			}
+		}
+		if len(matched) > 0 {
+			applyRules(n, matched)
+		}
+		return true
+	})
+
+	// قوانینی که قابل Inline شدن نیستند (مثل @media و :hover) در یک <style> باقی می‌مانند
+	first := styleNodes[0]
+	for _, s := range styleNodes[1:] {
+		s.Parent.RemoveChild(s)
+	}
+	if strings.TrimSpace(residual) == "" {
+		first.Parent.RemoveChild(first)
+		return
+	}
+	for c := first.FirstChild; c != nil; c = first.FirstChild {
+		first.RemoveChild(c)
+	}
+	first.AppendChild(&html.Node{Type: html.TextNode, Data: residual})
+}
+
+// parseStylesheet قوانین قابل Inline و متن باقیمانده (at-rule ها و pseudo ها) را جدا می‌کند
+func parseStylesheet(css string) ([]cssRule, string) {
+	original := css
+	css = cssCommentRe.ReplaceAllString(css, "")
+
+	var rules []cssRule
+	var residual strings.Builder
+	order := 0
+
+	for i := 0; i < len(css); {
+		open := strings.IndexByte(css[i:], '{')
+		if open < 0 {
+			break
+		}
+		prelude := strings.TrimSpace(css[i : i+open])
+		end := matchingBrace(css, i+open)
+		if end < 0 {
+			// قانون بسته نشده؛ CSS بدون تغییر در <style> می‌ماند
+			return nil, original
+		}
+		body := css[i+open+1 : end]
+		block := css[i : end+1]
+		i = end + 1
+
+		if strings.HasPrefix(prelude, "@") {
+			residual.WriteString(strings.TrimSpace(block))
+			residual.WriteString("\n")
+			continue
+		}
+
+		decls := parseDeclarations(body)
+		for _, sel := range strings.Split(prelude, ",") {
+			sel = strings.TrimSpace(sel)
+			compounds, spec, ok := parseSelector(sel)
+			if !ok {
+				residual.WriteString(sel + " {" + body + "}\n")
+				continue
+			}
+			rules = append(rules, cssRule{Selector: compounds, Specificity: spec, Order: order, Decls: decls})
+			order++
+		}
+	}
+
+	return rules, residual.String()
+}
+
+// matchingBrace اندیس } متناظر یا -1 اگر بسته نشده باشد
+func matchingBrace(s string, open int) int {
+	depth := 0
+	for j := open; j < len(s); j++ {
+		switch s[j] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return j
+			}
+		}
+	}
+	return -1
+}
+
+func parseDeclarations(body string) []cssDeclaration {
+	var decls []cssDeclaration
+	for _, part := range strings.Split(body, ";") {
+		colon := strings.IndexByte(part, ':')
+		if colon < 0 {
+			continue
+		}
+		prop := strings.ToLower(strings.TrimSpace(part[:colon]))
+		val := strings.TrimSpace(part[colon+1:])
+		if prop == "" || val == "" {
+			continue
+		}
+		important := false
+		if idx := strings.Index(strings.ToLower(val), "!important"); idx >= 0 {
+			important = true
+			val = strings.TrimSpace(val[:idx])
+		}
+		decls = append(decls, cssDeclaration{Property: prop, Value: val, Important: important})
+	}
+	return decls
+}
+
+// parseSelector فقط سلکتورهای ساده (tag, .class, #id, descendant, child) را پشتیبانی می‌کند
+func parseSelector(sel string) ([]cssCompound, [3]int, bool) {
+	var spec [3]int
+	if sel == "" || strings.ContainsAny(sel, ":[+~") {
+		return nil, spec, false
+	}
+
+	sel = strings.ReplaceAll(sel, ">", " > ")
+	var compounds []cssCompound
+	combinator := byte(' ')
+	for _, tok := range strings.Fields(sel) {
+		if tok == ">" {
+			combinator = '>'
+			continue
+		}
+		m := cssCompoundRe.FindStringSubmatch(tok)
+		if m == nil {
+			return nil, spec, false
+		}
+		c := cssCompound{Tag: strings.ToLower(m[1]), Combinator: combinator}
+		if c.Tag == "*" {
+			c.Tag = ""
+		} else if c.Tag != "" {
+			spec[2]++
+		}
+		for _, part := range cssPartRe.FindAllString(m[2], -1) {
+			if part[0] == '#' {
+				c.ID = part[1:]
+				spec[0]++
+			} else {
+				c.Classes = append(c.Classes, part[1:])
+				spec[1]++
+			}
+		}
+		compounds = append(compounds, c)
+		combinator = ' '
+	}
+	return compounds, spec, len(compounds) > 0
+}
+
+func matchSelector(n *html.Node, compounds []cssCompound) bool {
+	last := len(compounds) - 1
+	if !matchCompound(n, compounds[last]) {
+		return false
+	}
+	return matchAncestors(n, compounds[:last], compounds[last].Combinator)
+}
+
+func matchAncestors(n *html.Node, rest []cssCompound, combinator byte) bool {
+	if len(rest) == 0 {
+		return true
+	}
+	last := len(rest) - 1
+	for p := n.Parent; p != nil && p.Type == html.ElementNode; p = p.Parent {
+		if matchCompound(p, rest[last]) && matchAncestors(p, rest[:last], rest[last].Combinator) {
+			return true
+		}
+		if combinator == '>' {
+			return false
+		}
+	}
+	return false
+}
+
+func matchCompound(n *html.Node, c cssCompound) bool {
+	if c.Tag != "" && n.Data != c.Tag {
+		return false
+	}
+	if c.ID != "" && attr(n, "id") != c.ID {
+		return false
+	}
+	if len(c.Classes) > 0 {
+		classes := strings.Fields(attr(n, "class"))
+		for _, want := range c.Classes {
+			found := false
+			for _, have := range classes {
+				if have == want {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// applyRules قوانین منطبق را به ترتیب Specificity اعمال می‌کند؛ style موجود روی المان اولویت دارد
+func applyRules(n *html.Node, matched []cssRule) {
+	sort.SliceStable(matched, func(i, j int) bool {
+		a, b := matched[i], matched[j]
+		if a.Specificity != b.Specificity {
+			for k := 0; k < 3; k++ {
+				if a.Specificity[k] != b.Specificity[k] {
+					return a.Specificity[k] < b.Specificity[k]
+				}
+			}
+		}
+		return a.Order < b.Order
+	})
+
+	var keys []string
+	values := make(map[string]cssDeclaration)
+	set := func(d cssDeclaration) {
+		if old, ok := values[d.Property]; ok {
+			if old.Important && !d.Important {
+				return
+			}
+		} else {
+			keys = append(keys, d.Property)
+		}
+		values[d.Property] = d
+	}
+
+	for _, r := range matched {
+		for _, d := range r.Decls {
+			set(d)
+		}
+	}
+	for _, d := range parseDeclarations(attr(n, "style")) {
+		set(d)
+	}
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		d := values[k]
+		if d.Important {
+			parts = append(parts, d.Property+": "+d.Value+" !important")
+		} else {
+			parts = append(parts, d.Property+": "+d.Value)
+		}
+	}
+	setAttr(n, "style", strings.Join(parts, "; "))
+}
+
+// ---------------------------------------------------------
+// ساخت نسخه متنی (Plain Text)
+// ---------------------------------------------------------
+
+var blockElements = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "center": true,
+	"div": true, "dl": true, "dt": true, "dd": true, "footer": true, "form": true,
+	"header": true, "li": true, "main": true, "nav": true, "ol": true, "p": true,
+	"pre": true, "section": true, "table": true, "tbody": true, "thead": true,
+	"tfoot": true, "tr": true, "ul": true, "body": true, "html": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true, "hr": true,
+}
+
+type textWriter struct {
+	sb    strings.Builder
+	links *[]string
+	inPre bool
+}
+
+func htmlToPlainText(doc *html.Node) string {
+	var links []string
+	w := &textWriter{links: &links}
+	w.walk(doc)
+
+	text := cleanupPlainText(w.sb.String())
+	if len(links) > 0 {
+		var sb strings.Builder
+		sb.WriteString(text)
+		sb.WriteString("\n\n")
+		for i, l := range links {
+			fmt.Fprintf(&sb, "[%d] %s\n", i+1, l)
+		}
+		text = strings.TrimRight(sb.String(), "\n")
+	}
+	return text
+}
+
+func (w *textWriter) walk(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		if w.inPre {
+			w.sb.WriteString(n.Data)
+		} else {
+			w.sb.WriteString(spaceRe.ReplaceAllString(n.Data, " "))
+		}
+		return
+	case html.ElementNode:
+	default:
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			w.walk(c)
+		}
+		return
+	}
+
+	switch n.Data {
+	case "head", "script", "style", "title", "noscript":
+		return
+	case "br":
+		w.sb.WriteString("\n")
+		return
+	case "hr":
+		w.sb.WriteString("\n\n----------\n\n")
+		return
+	case "img":
+		if alt := strings.TrimSpace(attr(n, "alt")); alt != "" {
+			w.sb.WriteString(alt)
+		}
+		return
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		// تیترها با خط زیر مشخص می‌شوند (= برای h1 و - برای بقیه)
+		sub := &textWriter{links: w.links}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			sub.walk(c)
+		}
+		title := strings.TrimSpace(spaceRe.ReplaceAllString(sub.sb.String(), " "))
+		if title == "" {
+			return
+		}
+		underline := "-"
+		if n.Data == "h1" {
+			underline = "="
+		}
+		w.sb.WriteString("\n\n" + title + "\n" + strings.Repeat(underline, utf8.RuneCountInString(title)) + "\n\n")
+		return
+	case "a":
+		before := w.sb.Len()
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			w.walk(c)
+		}
+		label := strings.TrimSpace(w.sb.String()[before:])
+		// لینک‌ها به صورت پاورقی [n] در انتهای متن می‌آیند
+		href := strings.TrimSpace(attr(n, "href"))
+		if href != "" && !strings.HasPrefix(href, "#") && !strings.HasPrefix(href, "mailto:") && href != label {
+			*w.links = append(*w.links, href)
+			fmt.Fprintf(&w.sb, " [%d]", len(*w.links))
+		}
+		return
+	case "li":
+		w.sb.WriteString("\n* ")
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			w.walk(c)
+		}
+		w.sb.WriteString("\n")
+		return
+	case "td", "th":
+		w.sb.WriteString(" ")
+	case "pre":
+		w.inPre = true
+		defer func() { w.inPre = false }()
+	}
+
+	block := blockElements[n.Data]
+	if block {
+		w.sb.WriteString("\n\n")
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		w.walk(c)
+	}
+	if block {
+		w.sb.WriteString("\n\n")
+	}
+}
+
+var manyNewlinesRe = regexp.MustCompile(`\n{3,}`)
+
+func cleanupPlainText(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimSpace(l)
+	}
+	s = strings.Join(lines, "\n")
+	s = manyNewlinesRe.ReplaceAllString(s, "\n\n")
+	return strings.TrimSpace(s)
+}
+
+// ---------------------------------------------------------
+// Minify
+// ---------------------------------------------------------
+
+var preserveWhitespace = map[string]bool{"pre": true, "textarea": true, "script": true, "style": true}
+
+func minifyNode(n *html.Node) {
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		switch c.Type {
+		case html.CommentNode:
+			// کامنت‌های شرطی Outlook (<!--[if mso]>) نباید حذف شوند
+			if !strings.HasPrefix(strings.TrimSpace(c.Data), "[if") && !strings.HasPrefix(strings.TrimSpace(c.Data), "<![endif") {
+				n.RemoveChild(c)
+			}
+		case html.TextNode:
+			if n.Type == html.ElementNode && preserveWhitespace[n.Data] {
+				break
+			}
+			collapsed := spaceRe.ReplaceAllString(c.Data, " ")
+			if strings.TrimSpace(collapsed) == "" && (isBlockSibling(c.PrevSibling) || isBlockSibling(c.NextSibling)) {
+				n.RemoveChild(c)
+				break
+			}
+			c.Data = collapsed
+		case html.ElementNode:
+			if !preserveWhitespace[c.Data] {
+				minifyNode(c)
+			}
+		default:
+			minifyNode(c)
+		}
+		c = next
+	}
+}
+
+func isBlockSibling(n *html.Node) bool {
+	if n == nil {
+		return true
+	}
+	if n.Type != html.ElementNode {
+		return false
+	}
+	switch n.Data {
+	case "td", "th", "tr", "tbody", "thead", "tfoot", "head", "meta", "link", "style", "title":
+		return true
+	}
+	return blockElements[n.Data]
+}
+
+// ---------------------------------------------------------
+// ابزارهای کمکی DOM
+// ---------------------------------------------------------
+
+// walkNodes پیمایش عمقی درخت؛ اگر fn مقدار false برگرداند فرزندان آن نود پیمایش نمی‌شوند
+func walkNodes(n *html.Node, fn func(*html.Node) bool) {
+	if !fn(n) {
+		return
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walkNodes(c, fn)
+	}
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func setAttr(n *html.Node, key, val string) {
+	for i, a := range n.Attr {
+		if a.Key == key {
+			n.Attr[i].Val = val
+			return
+		}
+	}
+	n.Attr = append(n.Attr, html.Attribute{Key: key, Val: val})
+}
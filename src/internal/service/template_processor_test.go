@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+)
+
+func TestTemplateProcessorProcess(t *testing.T) {
+	all := domain.TemplateProcessingOptions{InlineCSS: true, GeneratePlainText: true, Minify: true}
+
+	tests := []struct {
+		name        string
+		opts        domain.TemplateProcessingOptions
+		html        string
+		contains    []string
+		notContains []string
+		plainText   string
+	}{
+		{
+			name:        "inlines simple selectors",
+			opts:        domain.TemplateProcessingOptions{InlineCSS: true},
+			html:        `<html><head><style>p.lead { color: red } #x { font-weight: bold }</style></head><body><p class="lead" id="x">hi</p></body></html>`,
+			contains:    []string{`style="color: red; font-weight: bold"`},
+			notContains: []string{"<style>"},
+		},
+		{
+			name:     "element style wins over stylesheet",
+			opts:     domain.TemplateProcessingOptions{InlineCSS: true},
+			html:     `<style>p { color: red }</style><p style="color: blue">hi</p>`,
+			contains: []string{`style="color: blue"`},
+		},
+		{
+			name:     "keeps media queries and pseudo classes",
+			opts:     domain.TemplateProcessingOptions{InlineCSS: true},
+			html:     `<style>a:hover { color: red } @media (max-width: 600px) { p { margin: 0 } }</style><p>hi</p>`,
+			contains: []string{"a:hover {", "@media (max-width: 600px)"},
+		},
+		{
+			name:     "unterminated rule leaves css unchanged",
+			opts:     domain.TemplateProcessingOptions{InlineCSS: true},
+			html:     `<style>p {</style><p>hi</p>`,
+			contains: []string{"<style>p {</style>", "<p>hi</p>"},
+		},
+		{
+			name:     "unterminated rule after a valid one",
+			opts:     domain.TemplateProcessingOptions{InlineCSS: true},
+			html:     `<style>b { color: red } p { margin: 0</style><p>hi</p>`,
+			contains: []string{"<style>b { color: red } p { margin: 0</style>", "<p>hi</p>"},
+		},
+		{
+			name:        "fragments are not wrapped in a document",
+			opts:        all,
+			html:        `<p>hello</p>`,
+			contains:    []string{"<p>hello</p>"},
+			notContains: []string{"<html>", "<head>", "<body>"},
+		},
+		{
+			name:        "template actions survive the round trip",
+			opts:        all,
+			html:        `<p>Hi {{ first_name }}</p>{{block "footer" .}}<a href="{{unsubscribe_url}}">Unsubscribe</a>`,
+			contains:    []string{`{{block "footer" .}}`, `href="{{unsubscribe_url}}"`, "Hi {{ first_name }}"},
+			notContains: []string{"&#34;", "__tpl_action_"},
+		},
+		{
+			name:        "action in place of an attribute",
+			opts:        domain.TemplateProcessingOptions{Minify: true},
+			html:        `<table><tr><td {{if .wide}}colspan="2"{{end}}>x</td></tr></table>`,
+			contains:    []string{`<td {{if .wide}}colspan="2" {{end}}>`},
+			notContains: []string{`=""`},
+		},
+		{
+			name:        "minify drops comments but keeps outlook conditionals",
+			opts:        domain.TemplateProcessingOptions{Minify: true},
+			html:        "<div>\n  <!-- note -->\n  <!--[if mso]><table><![endif]-->\n  <p>a   b</p>\n</div>",
+			contains:    []string{"<!--[if mso]>", "<p>a b</p>"},
+			notContains: []string{"note"},
+		},
+		{
+			name:      "plain text with headings and link footnotes",
+			opts:      domain.TemplateProcessingOptions{GeneratePlainText: true},
+			html:      `<h1>Sale</h1><p>Read <a href="https://example.com/a">more</a></p><ul><li>one</li></ul>`,
+			plainText: "Sale\n====\n\nRead more [1]\n\n* one\n\n[1] https://example.com/a",
+		},
+		{
+			name:      "plain text keeps template actions",
+			opts:      domain.TemplateProcessingOptions{GeneratePlainText: true},
+			html:      `<p>Hi {{ first_name }}, <a href="{{unsubscribe_url}}">leave</a></p>`,
+			plainText: "Hi {{ first_name }}, leave [1]\n\n[1] {{unsubscribe_url}}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &domain.TemplateVersion{HTMLContent: tt.html}
+			if err := NewTemplateProcessor(tt.opts).Process(context.Background(), v); err != nil {
+				t.Fatalf("Process: %v", err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(v.HTMLContent, want) {
+					t.Errorf("html does not contain %q:\n%s", want, v.HTMLContent)
+				}
+			}
+			for _, unwanted := range tt.notContains {
+				if strings.Contains(v.HTMLContent, unwanted) {
+					t.Errorf("html contains %q:\n%s", unwanted, v.HTMLContent)
+				}
+			}
+			if tt.plainText != "" && v.PlainText != tt.plainText {
+				t.Errorf("plain text = %q, want %q", v.PlainText, tt.plainText)
+			}
+		})
+	}
+}
+
+func TestTemplateProcessorKeepsUserPlainText(t *testing.T) {
+	v := &domain.TemplateVersion{HTMLContent: "<p>html</p>", PlainText: "mine"}
+	if err := NewTemplateProcessor(domain.TemplateProcessingOptions{GeneratePlainText: true}).Process(context.Background(), v); err != nil {
+		t.Fatal(err)
+	}
+	if v.PlainText != "mine" {
+		t.Errorf("plain text = %q, want the user supplied text", v.PlainText)
+	}
+}
+
+func TestTemplateProcessorSizeWarning(t *testing.T) {
+	v := &domain.TemplateVersion{HTMLContent: "<p>" + strings.Repeat("x", 2048) + "</p>"}
+	if err := NewTemplateProcessor(domain.TemplateProcessingOptions{SizeWarningBytes: 1024}).Process(context.Background(), v); err != nil {
+		t.Fatal(err)
+	}
+	if len(v.Warnings) != 1 || !strings.Contains(v.Warnings[0], "clipping threshold") {
+		t.Errorf("warnings = %v, want one clipping warning", v.Warnings)
+	}
+}
+
+func TestParseStylesheet(t *testing.T) {
+	tests := []struct {
+		css      string
+		rules    int
+		residual string
+	}{
+		{css: "p { color: red } .a, #b { margin: 0 }", rules: 3},
+		{css: "/* c */ td > p { color: red }", rules: 1},
+		{css: "p {", residual: "p {"},
+		{css: "p { color: red", residual: "p { color: red"},
+		{css: "@media print { p { color: red } }", residual: "@media print { p { color: red } }\n"},
+		{css: "", rules: 0},
+	}
+	for _, tt := range tests {
+		rules, residual := parseStylesheet(tt.css)
+		if len(rules) != tt.rules || residual != tt.residual {
+			t.Errorf("parseStylesheet(%q) = %d rules, residual %q; want %d, %q", tt.css, len(rules), residual, tt.rules, tt.residual)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	xhtml "golang.org/x/net/html"
+)
+
+// الگوی Merge Tag ها مثل {{first_name}} یا {{ first_name }}
+var mergeTagRe = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+// mergeTags مقادیر مخاطب را جایگذاری می‌کند؛ تگ‌های ناشناخته دست نمی‌خورند تا سرویس‌های بعدی (مثلاً unsubscribe_url) پرشان کنند
+func mergeTags(content string, vars map[string]string, escape bool) string {
+	if content == "" || len(vars) == 0 {
+		return content
+	}
+	return mergeTagRe.ReplaceAllStringFunc(content, func(tag string) string {
+		key := mergeTagRe.FindStringSubmatch(tag)[1]
+		val, ok := vars[key]
+		if !ok {
+			return tag
+		}
+		if escape {
+			return html.EscapeString(val)
+		}
+		return val
+	})
+}
+
+// applyDirection برای زبان‌های راست‌به‌چپ dir و lang را روی html/body ست می‌کند
+// و محتوای body را در یک div با dir="rtl" قرار می‌دهد (Gmail ویژگی‌های html و body را حذف می‌کند)
+func applyDirection(content, lang, dir string) (string, error) {
+	doc, err := xhtml.Parse(strings.NewReader(content))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template html: %w", err)
+	}
+
+	var body *xhtml.Node
+	walkNodes(doc, func(n *xhtml.Node) bool {
+		if n.Type != xhtml.ElementNode {
+			return true
+		}
+		switch n.Data {
+		case "html":
+			setAttr(n, "dir", dir)
+			setAttr(n, "lang", lang)
+		case "body":
+			setAttr(n, "dir", dir)
+			body = n
+			return false
+		}
+		return true
+	})
+
+	if body != nil {
+		align := "left"
+		if dir == "rtl" {
+			align = "right"
+		}
+		wrapper := &xhtml.Node{
+			Type: xhtml.ElementNode,
+			Data: "div",
+			Attr: []xhtml.Attribute{
+				{Key: "dir", Val: dir},
+				{Key: "style", Val: "direction: " + dir + "; text-align: " + align},
+			},
+		}
+		for c := body.FirstChild; c != nil; c = body.FirstChild {
+			body.RemoveChild(c)
+			wrapper.AppendChild(c)
+		}
+		body.AppendChild(wrapper)
+	}
+
+	var sb strings.Builder
+	if err := xhtml.Render(&sb, doc); err != nil {
+		return "", fmt.Errorf("failed to render template html: %w", err)
+	}
+	return sb.String(), nil
+}
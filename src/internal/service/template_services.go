@@ -2,9 +2,12 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
 	"github.com/ehsanshah/campaign-services/src/internal/core/port"
@@ -12,12 +15,97 @@ import (
 
 // ۱. اصلاح فیلد repo: باید ریپازیتوری باشد نه سرویس!
 type templateServices struct {
-	repo port.ITemplateRepository
+	repo      port.ITemplateRepository
+	processor port.ITemplateProcessor // اختیاری؛ nil یعنی پایپ‌لاین پردازش غیرفعال است
+	linter    port.ITemplateLinter
+	blocks    port.IBlockExpander // اختیاری؛ nil یعنی {{block "..."}} ها باز نمی‌شوند
 }
 
-func NewTemplateServices(repo port.ITemplateRepository) port.ITemplateServices {
+func NewTemplateServices(repo port.ITemplateRepository, processor port.ITemplateProcessor, linter port.ITemplateLinter, blocks port.IBlockExpander) port.ITemplateServices {
 	return &templateServices{
-		repo: repo,
+		repo:      repo,
+		processor: processor,
+		linter:    linter,
+		blocks:    blocks,
+	}
+}
+
+// saveVersion قبل از ذخیره، پایپ‌لاین پردازش HTML را (در صورت فعال بودن) اجرا می‌کند
+func (s *templateServices) saveVersion(ctx context.Context, v *domain.TemplateVersion) error {
+	// بلاک‌ها در نسخه ذخیره نمی‌شوند، فقط ارجاعشان برای Where Used ثبت می‌شود؛
+	// ارجاع‌ها از محتوای ارسالی کاربر و قبل از پایپ‌لاین پردازش خوانده می‌شوند
+	v.BlockTags = domain.ExtractBlockTags(v.HTMLContent, v.PlainText)
+	if s.processor != nil {
+		if err := s.processor.Process(ctx, v); err != nil {
+			return err
+		}
+	}
+	return s.repo.SaveVersion(ctx, v)
+}
+
+// expandBlocks بلاک‌های ارجاع داده شده در نسخه را با محتوای فعلی‌شان جایگزین می‌کند (روی یک کپی)
+func (s *templateServices) expandBlocks(ctx context.Context, accountID string, v *domain.TemplateVersion) (*domain.TemplateVersion, error) {
+	return expandVersionBlocks(ctx, s.blocks, accountID, v)
+}
+
+// expandVersionBlocks نسخه با بلاک‌های باز شده (کپی)؛ بدون blocks یا بدون ارجاع همان نسخه برگردانده می‌شود
+func expandVersionBlocks(ctx context.Context, blocks port.IBlockExpander, accountID string, v *domain.TemplateVersion) (*domain.TemplateVersion, error) {
+	if blocks == nil || len(domain.ExtractBlockTags(v.HTMLContent, v.PlainText)) == 0 {
+		return v, nil
+	}
+	out := *v
+	var err error
+	if out.HTMLContent, err = blocks.ExpandBlocks(ctx, accountID, v.HTMLContent, true); err != nil {
+		return nil, err
+	}
+	if out.PlainText, err = blocks.ExpandBlocks(ctx, accountID, v.PlainText, false); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// makeCurrent نسخه را نسخه فعلی زبان خودش می‌کند؛ نسخه زبان پیش‌فرض در CurrentVersionID هم ثبت می‌شود
+func (s *templateServices) makeCurrent(ctx context.Context, t *domain.Template, v *domain.TemplateVersion) error {
+	if err := s.repo.SetCurrentVersion(ctx, t.ID, v.Language, v.ID); err != nil {
+		return err
+	}
+	if t.CurrentVersions == nil {
+		t.CurrentVersions = make(map[string]string)
+	}
+	t.CurrentVersions[v.Language] = v.ID
+
+	if v.Language == t.DefaultLanguage || t.CurrentVersionID == "" {
+		t.CurrentVersionID = v.ID
+		return s.repo.SaveTemplate(ctx, t)
+	}
+	return nil
+}
+
+// initialStatus نسخه‌های قالب‌های دارای بازبین در وضعیت pending_review شروع می‌شوند
+func initialStatus(t *domain.Template, v *domain.TemplateVersion) {
+	v.Status = domain.VersionStatusApproved
+	if t.RequiresReview() {
+		v.Status = domain.VersionStatusPendingReview
+	}
+}
+
+// checkLock ذخیره نسخه توسط کاربری غیر از صاحب قفل فعال را رد می‌کند
+func (s *templateServices) checkLock(ctx context.Context, templateID, userID string) error {
+	lock, err := s.repo.GetLock(ctx, templateID)
+	if err != nil {
+		return err
+	}
+	if lock != nil && lock.LockedBy != userID && lock.ExpiresAt.After(time.Now()) {
+		return fmt.Errorf("template is locked by %s until %s", lock.LockedBy, lock.ExpiresAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// normalizeVersionLanguage زبان خالی نسخه را با زبان پیش‌فرض قالب پر می‌کند
+func normalizeVersionLanguage(t *domain.Template, v *domain.TemplateVersion) {
+	v.Language = domain.NormalizeLanguage(v.Language)
+	if v.Language == "" {
+		v.Language = t.DefaultLanguage
 	}
 }
 
@@ -28,15 +116,28 @@ func NewTemplateServices(repo port.ITemplateRepository) port.ITemplateServices {
 // 1️⃣ متد CreateTemplate
 
 func (s *templateServices) CreateTemplate(ctx context.Context, t *domain.Template, v *domain.TemplateVersion) (*domain.Template, error) {
+	// زبان نسخه اول، زبان پیش‌فرض قالب می‌شود
+	if t.DefaultLanguage = domain.NormalizeLanguage(t.DefaultLanguage); t.DefaultLanguage == "" {
+		t.DefaultLanguage = domain.NormalizeLanguage(v.Language)
+	}
+	if t.DefaultLanguage == "" {
+		t.DefaultLanguage = domain.DefaultTemplateLanguage
+	}
 	if err := s.repo.SaveTemplate(ctx, t); err != nil {
 		return nil, err
 	}
 	v.TemplateID = t.ID
-	if err := s.repo.SaveVersion(ctx, v); err != nil {
+	normalizeVersionLanguage(t, v)
+	initialStatus(t, v)
+	if err := s.saveVersion(ctx, v); err != nil {
+		return nil, err
+	}
+	if !v.IsApproved() {
+		return t, nil
+	}
+	if err := s.makeCurrent(ctx, t, v); err != nil {
 		return nil, err
 	}
-	t.CurrentVersionID = v.ID
-	_ = s.repo.SaveTemplate(ctx, t)
 	return t, nil
 }
 
@@ -49,15 +150,25 @@ func (s *templateServices) UpdateTemplate(ctx context.Context, accountID string,
 		return nil, err
 	}
 
+	if err := s.checkLock(ctx, templateID, v.CreatedBy); err != nil {
+		return nil, err
+	}
+
 	// ذخیره نسخه جدید
 	v.TemplateID = templateID
-	if err := s.repo.SaveVersion(ctx, v); err != nil {
+	normalizeVersionLanguage(t, v)
+	initialStatus(t, v)
+	if err := s.saveVersion(ctx, v); err != nil {
 		return nil, err
 	}
 
-	// بروزرسانی نسخه فعلی قالب
-	t.CurrentVersionID = v.ID
-	if err := s.repo.SaveTemplate(ctx, t); err != nil {
+	// نسخه در انتظار بازبینی تا زمان تایید نسخه فعلی نمی‌شود
+	if !v.IsApproved() {
+		return t, nil
+	}
+
+	// بروزرسانی نسخه فعلی همان زبان (نسخه‌های زبان‌های دیگر دست نمی‌خورند)
+	if err := s.makeCurrent(ctx, t, v); err != nil {
 		return nil, err
 	}
 
@@ -67,34 +178,68 @@ func (s *templateServices) UpdateTemplate(ctx context.Context, accountID string,
 // 3️⃣ متد CopyTemplate
 
 func (s *templateServices) CopyTemplate(ctx context.Context, accountID, sourceID, newName string) (*domain.Template, error) {
-	_, oldV, err := s.repo.GetTemplate(ctx, accountID, sourceID)
+	oldT, oldV, err := s.repo.GetTemplate(ctx, accountID, sourceID)
 	if err != nil {
 		return nil, fmt.Errorf("source template not found: %w", err)
 	}
 
 	newT := &domain.Template{
-		AccountID: accountID,
-		Name:      newName,
+		AccountID:       accountID,
+		Name:            newName,
+		DefaultLanguage: oldT.DefaultLanguage,
+		Reviewers:       oldT.Reviewers,
 	}
 	if err := s.repo.SaveTemplate(ctx, newT); err != nil {
 		return nil, err
 	}
 
-	newV := *oldV
-	newV.ID = ""
-	newV.TemplateID = newT.ID
-	newV.VersionLabel = "Copy of " + oldV.VersionLabel
-
-	if err := s.repo.SaveVersion(ctx, &newV); err != nil {
+	// همه نسخه‌های فعلی (به ازای هر زبان) کپی می‌شوند
+	variants, err := s.repo.GetCurrentVersions(ctx, sourceID)
+	if err != nil {
 		return nil, err
 	}
+	sources := []*domain.TemplateVersion{oldV}
+	for _, versionID := range variants {
+		if versionID == oldV.ID {
+			continue
+		}
+		v, err := s.repo.GetVersion(ctx, accountID, versionID)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, v)
+	}
+
+	for _, src := range sources {
+		newV := *src
+		newV.ID = ""
+		newV.TemplateID = newT.ID
+		newV.VersionLabel = "Copy of " + src.VersionLabel
+		newV.Warnings = nil
+		newV.LintResult = nil
+		normalizeVersionLanguage(newT, &newV)
+		initialStatus(newT, &newV)
 
-	newT.CurrentVersionID = newV.ID
-	_ = s.repo.SaveTemplate(ctx, newT)
+		if err := s.saveVersion(ctx, &newV); err != nil {
+			return nil, err
+		}
+		if !newV.IsApproved() {
+			continue
+		}
+		if err := s.makeCurrent(ctx, newT, &newV); err != nil {
+			return nil, err
+		}
+	}
 
 	return newT, nil
 }
 
+// متد ListTemplates
+
+func (s *templateServices) ListTemplates(ctx context.Context, accountID string, limit, offset int32) ([]*domain.Template, int32, error) {
+	return s.repo.ListTemplates(ctx, accountID, limit, offset)
+}
+
 // 4️⃣ متد ImportTemplateFromUrl
 
 func (s *templateServices) ImportTemplateFromUrl(ctx context.Context, accountID, name, url string) (*domain.Template, error) {
@@ -106,7 +251,7 @@ func (s *templateServices) ImportTemplateFromUrl(ctx context.Context, accountID,
 
 	body, _ := io.ReadAll(resp.Body)
 
-	t := &domain.Template{AccountID: accountID, Name: name}
+	t := &domain.Template{AccountID: accountID, Name: name, DefaultLanguage: domain.DefaultTemplateLanguage}
 	if err := s.repo.SaveTemplate(ctx, t); err != nil {
 		return nil, err
 	}
@@ -116,13 +261,16 @@ func (s *templateServices) ImportTemplateFromUrl(ctx context.Context, accountID,
 		HTMLContent:  string(body),
 		Subject:      "Imported Template",
 		VersionLabel: "v1 (Imported)",
+		Language:     t.DefaultLanguage,
+		Status:       domain.VersionStatusApproved,
 	}
-	if err := s.repo.SaveVersion(ctx, v); err != nil {
+	if err := s.saveVersion(ctx, v); err != nil {
 		return nil, err
 	}
 
-	t.CurrentVersionID = v.ID
-	_ = s.repo.SaveTemplate(ctx, t)
+	if err := s.makeCurrent(ctx, t, v); err != nil {
+		return nil, err
+	}
 
 	return t, nil
 }
@@ -134,3 +282,373 @@ func (s *templateServices) TestTemplate(ctx context.Context, accountID, template
 	fmt.Printf("Sending test email to %s for template %s\n", testEmail, templateID)
 	return nil
 }
+
+// 6️⃣ متد LintTemplate: بررسی کیفیت نسخه و ذخیره نتیجه روی همان نسخه
+
+func (s *templateServices) LintTemplate(ctx context.Context, accountID, templateID, versionID string) (*domain.TemplateLintResult, error) {
+	if s.linter == nil {
+		return nil, fmt.Errorf("template linter is not configured")
+	}
+
+	var v *domain.TemplateVersion
+	var err error
+	if versionID == "" {
+		_, v, err = s.repo.GetTemplate(ctx, accountID, templateID)
+	} else {
+		v, err = s.repo.GetVersion(ctx, accountID, versionID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("template version not found: %w", err)
+	}
+	if v.TemplateID != templateID {
+		return nil, fmt.Errorf("version %s does not belong to template %s", v.ID, templateID)
+	}
+
+	// Lint روی محتوای نهایی (با بلاک‌های باز شده) انجام می‌شود
+	expanded, err := s.expandBlocks(ctx, accountID, v)
+	if err != nil {
+		return nil, err
+	}
+	result := s.linter.Lint(ctx, expanded)
+	if err := s.repo.SaveLintResult(ctx, v.ID, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// 7️⃣ متد SetCurrentVersion: یک نسخه موجود (مثلاً بازگرداندن نسخه قدیمی) را نسخه فعلی زبان خودش می‌کند
+
+func (s *templateServices) SetCurrentVersion(ctx context.Context, accountID, templateID, versionID, userID string) (*domain.Template, error) {
+	t, _, err := s.repo.GetTemplate(ctx, accountID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkLock(ctx, templateID, userID); err != nil {
+		return nil, err
+	}
+	v, err := s.repo.GetVersion(ctx, accountID, versionID)
+	if err != nil {
+		return nil, fmt.Errorf("template version not found: %w", err)
+	}
+	if v.TemplateID != templateID {
+		return nil, fmt.Errorf("version %s does not belong to template %s", versionID, templateID)
+	}
+	if !v.IsApproved() {
+		return nil, fmt.Errorf("version %s is %s; only approved versions can become current", versionID, v.Status)
+	}
+
+	normalizeVersionLanguage(t, v)
+	if err := s.makeCurrent(ctx, t, v); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// 8️⃣ متد ResolveVariant: انتخاب نسخه بر اساس زنجیره Fallback زبان (fa-IR -> fa -> en)
+
+func (s *templateServices) ResolveVariant(ctx context.Context, accountID, templateID, language string) (*domain.TemplateVersion, error) {
+	t, current, err := s.repo.GetTemplate(ctx, accountID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	variants, err := s.repo.GetCurrentVersions(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := domain.LanguageFallbackChain(language, t.DefaultLanguage)
+	for _, lang := range chain {
+		versionID := variants[lang]
+		if versionID == "" {
+			continue
+		}
+		if versionID == current.ID {
+			return current, nil
+		}
+		return s.repo.GetVersion(ctx, accountID, versionID)
+	}
+
+	// هیچ زبانی از زنجیره پیدا نشد؛ نسخه فعلی زبان پیش‌فرض برگردانده می‌شود
+	// قالبی که هنوز نسخه تایید شده‌ای ندارد رندر نمی‌شود تا ایمیل خالی ارسال نشود
+	if t.CurrentVersionID == "" {
+		return nil, fmt.Errorf("template %s has no current version for language chain %v", templateID, chain)
+	}
+	return current, nil
+}
+
+// 9️⃣ متد RenderTemplate: رندر نسخه متناسب با زبان مخاطب و جایگذاری Merge Tag ها
+
+func (s *templateServices) RenderTemplate(ctx context.Context, accountID, templateID string, contact map[string]string) (*domain.RenderedTemplate, error) {
+	v, err := s.ResolveVariant(ctx, accountID, templateID, contactLanguage(contact))
+	if err != nil {
+		return nil, err
+	}
+	if v, err = s.expandBlocks(ctx, accountID, v); err != nil {
+		return nil, err
+	}
+	return renderContent(templateID, v.ID, v.Language, v.Subject, v.HTMLContent, v.PlainText, contact)
+}
+
+// 🔟 متد SnapshotTemplate: نسخه فعلی همه زبان‌های قالب با بلاک‌های باز شده برای کمپین فریز می‌شود
+// تا ویرایش قالب یا بلاک‌ها بعد از dispatch روی ایمیل‌های در صف اثر نگذارد؛ اسنپ‌شات موجود دوباره گرفته نمی‌شود
+
+func (s *templateServices) SnapshotTemplate(ctx context.Context, accountID, templateID, campaignID string) ([]*domain.TemplateSnapshot, error) {
+	existing, err := s.repo.ListSnapshots(ctx, campaignID, templateID)
+	if err != nil || len(existing) > 0 {
+		return existing, err
+	}
+
+	t, current, err := s.repo.GetTemplate(ctx, accountID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if t.CurrentVersionID == "" {
+		return nil, fmt.Errorf("template %s has no approved version", templateID)
+	}
+	variants, err := s.repo.GetCurrentVersions(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	versions := []*domain.TemplateVersion{current}
+	for _, versionID := range variants {
+		if versionID == current.ID {
+			continue
+		}
+		v, err := s.repo.GetVersion(ctx, accountID, versionID)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+
+	snaps := make([]*domain.TemplateSnapshot, 0, len(versions))
+	for _, v := range versions {
+		normalizeVersionLanguage(t, v)
+		expanded, err := s.expandBlocks(ctx, accountID, v)
+		if err != nil {
+			return nil, err
+		}
+		snap := &domain.TemplateSnapshot{
+			TemplateID:  templateID,
+			VersionID:   v.ID,
+			CampaignID:  campaignID,
+			Language:    v.Language,
+			Subject:     expanded.Subject,
+			HTMLContent: expanded.HTMLContent,
+			PlainText:   expanded.PlainText,
+			IsDefault:   v.ID == current.ID,
+		}
+		if err := s.repo.SaveSnapshot(ctx, snap); err != nil {
+			return nil, err
+		}
+		snaps = append(snaps, snap)
+	}
+	return snaps, nil
+}
+
+// RenderSnapshot مثل RenderTemplate ولی از اسنپ‌شات کمپین؛ کمپینی که بدون اسنپ‌شات به صف رفته از نسخه فعلی رندر می‌شود
+func (s *templateServices) RenderSnapshot(ctx context.Context, accountID, templateID, campaignID string, contact map[string]string) (*domain.RenderedTemplate, error) {
+	snaps, err := s.repo.ListSnapshots(ctx, campaignID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if len(snaps) == 0 {
+		return s.RenderTemplate(ctx, accountID, templateID, contact)
+	}
+
+	// ListSnapshots اسنپ‌شات زبان پیش‌فرض را اول برمی‌گرداند
+	snap := snaps[0]
+	byLanguage := make(map[string]*domain.TemplateSnapshot, len(snaps))
+	for _, sn := range snaps {
+		byLanguage[sn.Language] = sn
+	}
+	for _, lang := range domain.LanguageFallbackChain(contactLanguage(contact), snaps[0].Language) {
+		if sn, ok := byLanguage[lang]; ok {
+			snap = sn
+			break
+		}
+	}
+	return renderContent(templateID, snap.VersionID, snap.Language, snap.Subject, snap.HTMLContent, snap.PlainText, contact)
+}
+
+// contactLanguage زبان مخاطب از اولین کلید موجود در ContactLanguageKeys
+func contactLanguage(contact map[string]string) string {
+	for _, key := range domain.ContactLanguageKeys {
+		if language := contact[key]; language != "" {
+			return language
+		}
+	}
+	return ""
+}
+
+// renderContent جایگذاری Merge Tag ها و اعمال جهت متن؛ جهت بر اساس زبان نسخه تعیین می‌شود، نه زبان مخاطب
+func renderContent(templateID, versionID, language, subject, htmlContent, plainText string, contact map[string]string) (*domain.RenderedTemplate, error) {
+	out := &domain.RenderedTemplate{
+		TemplateID: templateID,
+		VersionID:  versionID,
+		Language:   language,
+		Dir:        "ltr",
+		Subject:    mergeTags(subject, contact, false),
+		HTML:       mergeTags(htmlContent, contact, true),
+		Text:       mergeTags(plainText, contact, false),
+	}
+	if domain.IsRTLLanguage(language) {
+		out.Dir = "rtl"
+		var err error
+		if out.HTML, err = applyDirection(out.HTML, language, out.Dir); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// 1️⃣1️⃣ متد SetReviewers: تعیین بازبین‌های قالب (خالی یعنی بدون گردش کار تایید)
+
+func (s *templateServices) SetReviewers(ctx context.Context, accountID, templateID string, reviewers []string) (*domain.Template, error) {
+	t, _, err := s.repo.GetTemplate(ctx, accountID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	t.Reviewers = reviewers
+	if err := s.repo.SaveTemplate(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// 1️⃣2️⃣ متد ApproveVersion: تایید نسخه توسط یکی از بازبین‌ها و فعلی کردن آن
+
+func (s *templateServices) ApproveVersion(ctx context.Context, accountID, templateID, versionID, reviewerID, comment string) (*domain.TemplateVersion, error) {
+	t, v, err := s.reviewableVersion(ctx, accountID, templateID, versionID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+	// تایید نسخه را فعلی می‌کند و قالب را ذخیره می‌کند؛ پس مثل ویرایش با قفل کاربر دیگر رد می‌شود
+	// (قبل از ثبت بازبینی، تا نسخه تایید شده‌ای که فعلی نشده باقی نماند)
+	if err := s.checkLock(ctx, templateID, reviewerID); err != nil {
+		return nil, err
+	}
+	if err := s.recordReview(ctx, v, reviewerID, domain.VersionStatusApproved, comment); err != nil {
+		return nil, err
+	}
+	if err := s.makeCurrent(ctx, t, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// 1️⃣3️⃣ متد RejectVersion: رد نسخه با توضیح بازبین
+
+func (s *templateServices) RejectVersion(ctx context.Context, accountID, templateID, versionID, reviewerID, comment string) (*domain.TemplateVersion, error) {
+	if strings.TrimSpace(comment) == "" {
+		return nil, errors.New("a comment is required when rejecting a version")
+	}
+	_, v, err := s.reviewableVersion(ctx, accountID, templateID, versionID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.recordReview(ctx, v, reviewerID, domain.VersionStatusRejected, comment); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (s *templateServices) ListReviews(ctx context.Context, accountID, templateID, versionID string) ([]domain.TemplateReview, error) {
+	v, err := s.repo.GetVersion(ctx, accountID, versionID)
+	if err != nil {
+		return nil, fmt.Errorf("template version not found: %w", err)
+	}
+	if v.TemplateID != templateID {
+		return nil, fmt.Errorf("version %s does not belong to template %s", versionID, templateID)
+	}
+	return s.repo.ListReviews(ctx, accountID, versionID)
+}
+
+// reviewableVersion شرایط بازبینی را بررسی می‌کند: بازبین نام‌برده، نسخه در انتظار و بازبین غیر از نویسنده
+func (s *templateServices) reviewableVersion(ctx context.Context, accountID, templateID, versionID, reviewerID string) (*domain.Template, *domain.TemplateVersion, error) {
+	t, _, err := s.repo.GetTemplate(ctx, accountID, templateID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !t.IsReviewer(reviewerID) {
+		return nil, nil, fmt.Errorf("%s is not a reviewer of template %s", reviewerID, templateID)
+	}
+	v, err := s.repo.GetVersion(ctx, accountID, versionID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("template version not found: %w", err)
+	}
+	if v.TemplateID != templateID {
+		return nil, nil, fmt.Errorf("version %s does not belong to template %s", versionID, templateID)
+	}
+	if v.Status != domain.VersionStatusPendingReview {
+		return nil, nil, fmt.Errorf("version %s is %s, not pending review", versionID, v.Status)
+	}
+	if v.CreatedBy != "" && v.CreatedBy == reviewerID {
+		return nil, nil, errors.New("authors cannot review their own versions")
+	}
+	normalizeVersionLanguage(t, v)
+	return t, v, nil
+}
+
+func (s *templateServices) recordReview(ctx context.Context, v *domain.TemplateVersion, reviewerID, decision, comment string) error {
+	review := &domain.TemplateReview{
+		TemplateID: v.TemplateID,
+		VersionID:  v.ID,
+		ReviewerID: reviewerID,
+		Decision:   decision,
+		Comment:    comment,
+	}
+	if err := s.repo.SaveReview(ctx, review); err != nil {
+		return err
+	}
+	if err := s.repo.SetVersionStatus(ctx, v.ID, decision); err != nil {
+		return err
+	}
+	v.Status = decision
+	return nil
+}
+
+// DeleteTemplate: حذف قالب هم مثل ویرایش با قفل کاربر دیگر رد می‌شود
+
+func (s *templateServices) DeleteTemplate(ctx context.Context, accountID, templateID, userID string) error {
+	if _, _, err := s.repo.GetTemplate(ctx, accountID, templateID); err != nil {
+		return err
+	}
+	if err := s.checkLock(ctx, templateID, userID); err != nil {
+		return err
+	}
+	return s.repo.DeleteTemplate(ctx, accountID, templateID)
+}
+
+// 1️⃣4️⃣ متد LockTemplate: گرفتن یا تمدید قفل ویرایش
+
+func (s *templateServices) LockTemplate(ctx context.Context, accountID, templateID, userID string) (*domain.TemplateLock, error) {
+	if userID == "" {
+		return nil, errors.New("user id is required to lock a template")
+	}
+	if _, _, err := s.repo.GetTemplate(ctx, accountID, templateID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	lock, err := s.repo.AcquireLock(ctx, &domain.TemplateLock{
+		TemplateID: templateID,
+		LockedBy:   userID,
+		LockedAt:   now,
+		ExpiresAt:  now.Add(domain.DefaultTemplateLockTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if lock.LockedBy != userID {
+		return lock, fmt.Errorf("template is locked by %s until %s", lock.LockedBy, lock.ExpiresAt.Format(time.RFC3339))
+	}
+	return lock, nil
+}
+
+func (s *templateServices) UnlockTemplate(ctx context.Context, accountID, templateID, userID string) error {
+	if _, _, err := s.repo.GetTemplate(ctx, accountID, templateID); err != nil {
+		return err
+	}
+	return s.repo.ReleaseLock(ctx, templateID, userID)
+}
@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+	xhtml "golang.org/x/net/html"
+)
+
+const (
+	trackOpenPurpose  = "open"
+	trackClickPurpose = "click"
+	// machineHitWindow باز کردن یا کلیک زودتر از این مدت بعد از رندر کار اسکنرهای امنیتی هنگام تحویل است
+	machineHitWindow = 10 * time.Second
+)
+
+// User-Agent اسکنرهای امنیتی و کلاینت‌های خودکار (حروف کوچک)
+var scannerAgents = []string{
+	"bot", "crawler", "spider", "scanner", "proofpoint", "mimecast", "barracuda", "safelinks",
+	"python-requests", "curl/", "wget/", "go-http-client", "java/", "okhttp", "headlesschrome", "phantomjs",
+}
+
+// appleNetwork شبکه اپل؛ Apple Mail Privacy Protection تصاویر را هنگام دریافت ایمیل از پراکسی‌های اپل می‌خواند
+var appleNetwork = mustCIDR("17.0.0.0/8")
+
+type trackingService struct {
+	signer  *linkSigner
+	baseURL string
+	repo    port.ITrackingRepository
+	events  port.IDeliveryEventService // باز کردن‌ها و کلیک‌های انسانی از همان مسیر رویدادها در آمار کمپین ثبت می‌شوند
+	engine  port.IAutomationEngine     // اختیاری؛ تعامل با ایمیل‌های Journey برای شرط‌های مراحل بعدی
+}
+
+func NewTrackingService(baseURL, signingKey string, repo port.ITrackingRepository, events port.IDeliveryEventService,
+	engine port.IAutomationEngine) (port.ITrackingService, error) {
+	signer, err := newLinkSigner(signingKey)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return nil, fmt.Errorf("invalid tracking base url %q", baseURL)
+	}
+	return &trackingService{
+		signer:  signer,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		repo:    repo,
+		events:  events,
+		engine:  engine,
+	}, nil
+}
+
+// Instrument لینک‌هایی که به خود سرور رهگیری اشاره می‌کنند (مثلاً لغو عضویت) بازنویسی نمی‌شوند
+func (s *trackingService) Instrument(html string, token domain.TrackingToken, opens, clicks bool) (string, error) {
+	if !opens && !clicks {
+		return html, nil
+	}
+	token.Email = strings.ToLower(strings.TrimSpace(token.Email))
+	if token.IssuedAt == 0 {
+		token.IssuedAt = time.Now().Unix()
+	}
+
+	var signErr error
+	var link func(href string) string
+	if clicks {
+		link = func(href string) string {
+			if strings.HasPrefix(href, s.baseURL+"/") {
+				return href
+			}
+			t := token
+			t.URL = href
+			signed, err := s.signer.sign(trackClickPurpose, t)
+			if err != nil {
+				signErr = err
+				return href
+			}
+			return s.baseURL + domain.TrackClickPath + signed
+		}
+	}
+
+	var pixel *xhtml.Node
+	if opens {
+		signed, err := s.signer.sign(trackOpenPurpose, token)
+		if err != nil {
+			return "", err
+		}
+		pixel = &xhtml.Node{
+			Type: xhtml.ElementNode,
+			Data: "img",
+			Attr: []xhtml.Attribute{
+				{Key: "src", Val: s.baseURL + domain.TrackOpenPath + signed},
+				{Key: "width", Val: "1"},
+				{Key: "height", Val: "1"},
+				{Key: "alt", Val: ""},
+				{Key: "style", Val: "display:block;width:1px;height:1px;border:0"},
+			},
+		}
+	}
+
+	out, err := rewriteLinks(html, link, pixel)
+	if err != nil {
+		return "", err
+	}
+	if signErr != nil {
+		return "", signErr
+	}
+	return out, nil
+}
+
+func (s *trackingService) RecordOpen(ctx context.Context, req domain.TrackingRequest) error {
+	var t domain.TrackingToken
+	if err := s.signer.verify(trackOpenPurpose, req.Token, &t); err != nil {
+		return err
+	}
+	return s.record(ctx, t, domain.EventOpened, req)
+}
+
+func (s *trackingService) RecordClick(ctx context.Context, req domain.TrackingRequest) (string, error) {
+	var t domain.TrackingToken
+	if err := s.signer.verify(trackClickPurpose, req.Token, &t); err != nil {
+		return "", err
+	}
+	u, err := url.Parse(t.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", port.ErrInvalidToken
+	}
+	return t.URL, s.record(ctx, t, domain.EventClicked, req)
+}
+
+func (s *trackingService) record(ctx context.Context, t domain.TrackingToken, eventType string, req domain.TrackingRequest) error {
+	now := time.Now()
+	hit := &domain.TrackingHit{
+		AccountID:  t.AccountID,
+		CampaignID: t.CampaignID,
+		Email:      t.Email,
+		Type:       eventType,
+		URL:        t.URL,
+		IPAddress:  req.IPAddress,
+		UserAgent:  req.UserAgent,
+		At:         now,
+	}
+	hit.MachineReason = machineReason(req, eventType, time.Unix(t.IssuedAt, 0), now)
+	hit.Machine = hit.MachineReason != ""
+
+	if err := s.repo.RecordHit(ctx, hit); err != nil {
+		return fmt.Errorf("record %s: %w", eventType, err)
+	}
+	if hit.Machine {
+		return nil
+	}
+	if t.JourneyID != "" && s.engine != nil {
+		if err := s.engine.RecordEngagement(ctx, t.AccountID, t.JourneyID, t.StepID, eventType, now); err != nil {
+			return fmt.Errorf("journey %s engagement: %w", t.JourneyID, err)
+		}
+	}
+	return s.events.HandleEvents(ctx, []domain.EmailEvent{{
+		AccountID:  hit.AccountID,
+		CampaignID: hit.CampaignID,
+		Type:       eventType,
+		Email:      hit.Email,
+		IPAddress:  hit.IPAddress,
+		UserAgent:  hit.UserAgent,
+		At:         now,
+	}})
+}
+
+// machineReason تشخیص تقریبی درخواست خودکار؛ رشته خالی یعنی باز کردن یا کلیک انسانی
+//
+// پراکسی تصاویر Gmail (GoogleImageProxy) تصویر را هنگام باز کردن واقعی می‌خواند و خودکار حساب نمی‌شود.
+func machineReason(req domain.TrackingRequest, eventType string, issuedAt, now time.Time) string {
+	ua := strings.TrimSpace(req.UserAgent)
+	lower := strings.ToLower(ua)
+	switch {
+	case req.Method == http.MethodHead:
+		return "head request"
+	case ua == "":
+		return "no user agent"
+	case containsAny(lower, scannerAgents...):
+		return "security scanner"
+	case eventType == domain.EventOpened && (ua == "Mozilla/5.0" || inNetwork(req.IPAddress, appleNetwork)):
+		return "apple mail privacy protection"
+	case !issuedAt.IsZero() && now.Sub(issuedAt) < machineHitWindow:
+		return "immediately after delivery"
+	}
+	return ""
+}
+
+func inNetwork(ip string, network *net.IPNet) bool {
+	parsed := net.ParseIP(ip)
+	return parsed != nil && network.Contains(parsed)
+}
+
+func mustCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+	"github.com/ehsanshah/campaign-services/src/internal/core/port"
+)
+
+const unsubscribeTokenPurpose = "unsubscribe"
+
+type unsubscribeService struct {
+	signer  *linkSigner
+	baseURL string
+	repo    port.IUnsubscribeRepository
+	events  port.IDeliveryEventService // Suppression و آمار کمپین از همان مسیر رویدادهای تحویل ثبت می‌شوند
+}
+
+// baseURL آدرس عمومی سرور HTTP است و برای لغو عضویت یک کلیکی Gmail و Yahoo باید https باشد
+func NewUnsubscribeService(baseURL, signingKey string, repo port.IUnsubscribeRepository, events port.IDeliveryEventService) (port.IUnsubscribeService, error) {
+	signer, err := newLinkSigner(signingKey)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return nil, fmt.Errorf("invalid tracking base url %q", baseURL)
+	}
+	return &unsubscribeService{
+		signer:  signer,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		repo:    repo,
+		events:  events,
+	}, nil
+}
+
+func (s *unsubscribeService) Headers(token domain.UnsubscribeToken) (map[string]string, error) {
+	token.Email = strings.ToLower(strings.TrimSpace(token.Email))
+	signed, err := s.signer.sign(unsubscribeTokenPurpose, token)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		"List-Unsubscribe":      "<" + s.baseURL + domain.UnsubscribePath + signed + ">",
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+	}, nil
+}
+
+func (s *unsubscribeService) Unsubscribe(ctx context.Context, token, source, ipAddress, userAgent string) (*domain.Unsubscribe, error) {
+	var t domain.UnsubscribeToken
+	if err := s.signer.verify(unsubscribeTokenPurpose, token, &t); err != nil {
+		return nil, err
+	}
+	if t.AccountID == "" || t.Email == "" {
+		return nil, port.ErrInvalidToken
+	}
+	if source != domain.UnsubscribeOneClick {
+		source = domain.UnsubscribeLink
+	}
+
+	u := &domain.Unsubscribe{
+		AccountID:  t.AccountID,
+		CampaignID: t.CampaignID,
+		ListID:     t.ListID,
+		Email:      t.Email,
+		Source:     source,
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+		CreatedAt:  time.Now(),
+	}
+	if err := s.repo.RecordUnsubscribe(ctx, u); err != nil {
+		return nil, err
+	}
+	// درخواست تکراری هم Suppression را دوباره ثبت می‌کند تا اگر بار قبل ثبت آن شکست خورده بود جبران شود
+	// (شمارنده‌های آمار کمپین هر گیرنده را یک بار حساب می‌کنند)
+	err := s.events.HandleEvents(ctx, []domain.EmailEvent{{
+		AccountID:  u.AccountID,
+		CampaignID: u.CampaignID,
+		Type:       domain.EventUnsubscribed,
+		Email:      u.Email,
+		IPAddress:  u.IPAddress,
+		UserAgent:  u.UserAgent,
+		At:         u.CreatedAt,
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("suppress unsubscribed recipient: %w", err)
+	}
+	return u, nil
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"unicode"
+
+	"github.com/ehsanshah/campaign-services/src/internal/core/domain"
+)
+
+const (
+	defaultUTMSource = "newsletter"
+	defaultUTMMedium = "email"
+)
+
+// ترتیب افزودن پارامترها به لینک
+var (
+	utmKeys       = []string{domain.UTMSource, domain.UTMMedium, domain.UTMCampaign, domain.UTMContent, domain.UTMTerm}
+	ecommerceKeys = []string{domain.EcommerceCampaignParam, domain.EcommerceRecipientParam}
+)
+
+// utmTags مقادیر پیش‌فرض (utm_source=newsletter، utm_medium=email، utm_campaign از name و utm_content از variation)
+// با overrides جایگزین می‌شوند؛ مقدار خالی در overrides یعنی آن پارامتر اضافه نشود
+func utmTags(name, variation string, overrides map[string]string) domain.UTMTags {
+	tags := domain.UTMTags{Params: map[string]string{
+		domain.UTMSource:   defaultUTMSource,
+		domain.UTMMedium:   defaultUTMMedium,
+		domain.UTMCampaign: slugify(name),
+		domain.UTMContent:  slugify(variation),
+	}}
+	for k, v := range overrides {
+		k = strings.ToLower(strings.TrimSpace(k))
+		v = strings.TrimSpace(v)
+		if k == domain.UTMExcludeDomains {
+			for _, d := range strings.Split(v, ",") {
+				if d = strings.ToLower(strings.Trim(strings.TrimSpace(d), ".")); d != "" {
+					tags.ExcludeDomains = append(tags.ExcludeDomains, d)
+				}
+			}
+			continue
+		}
+		if !strings.HasPrefix(k, "utm_") {
+			k = "utm_" + k
+		}
+		tags.Params[k] = v
+	}
+	for k, v := range tags.Params {
+		if v == "" {
+			delete(tags.Params, k)
+		}
+	}
+	return tags
+}
+
+// ecommerceIDs شناسه منبع (کمپین یا اتوماسیون) و هش ثابت ایمیل گیرنده برای نسبت دادن سفارش
+func ecommerceIDs(sourceID, email string) map[string]string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return map[string]string{
+		domain.EcommerceCampaignParam:  sourceID,
+		domain.EcommerceRecipientParam: hex.EncodeToString(sum[:8]),
+	}
+}
+
+// tagURL پارامترها را به انتهای Query اضافه می‌کند؛ Query و Fragment موجود بدون تغییر می‌مانند
+// و پارامتری که لینک از قبل دارد جایگزین نمی‌شود
+func tagURL(href string, tags domain.UTMTags) string {
+	if strings.Contains(href, "{{") {
+		return href
+	}
+	u, err := url.Parse(href)
+	if err != nil || u.Host == "" || excludedDomain(u.Hostname(), tags.ExcludeDomains) {
+		return href
+	}
+	existing, _ := url.ParseQuery(u.RawQuery)
+
+	var add []string
+	appendParams := func(keys []string, params map[string]string) {
+		for _, k := range keys {
+			if v := params[k]; v != "" && !existing.Has(k) {
+				add = append(add, k+"="+url.QueryEscape(v))
+			}
+		}
+	}
+	appendParams(utmKeys, tags.Params)
+	appendParams(ecommerceKeys, tags.Ecommerce)
+	if len(add) == 0 {
+		return href
+	}
+
+	base, fragment, hasFragment := strings.Cut(href, "#")
+	sep := "?"
+	if strings.Contains(base, "?") {
+		sep = "&"
+		if strings.HasSuffix(base, "?") || strings.HasSuffix(base, "&") {
+			sep = ""
+		}
+	}
+	out := base + sep + strings.Join(add, "&")
+	if hasFragment {
+		out += "#" + fragment
+	}
+	return out
+}
+
+// excludedDomain دامنه یا زیردامنه‌های آن
+func excludedDomain(host string, domains []string) bool {
+	host = strings.ToLower(host)
+	for _, d := range domains {
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// slugify مثلاً "Black Friday 2026!" ← black-friday-2026 (حروف غیر لاتین حفظ می‌شوند)
+func slugify(s string) string {
+	var sb strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(s)) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && sb.Len() > 0 {
+				sb.WriteByte('-')
+			}
+			sb.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return sb.String()
+}
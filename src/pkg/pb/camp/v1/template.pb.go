@@ -88,7 +88,8 @@ type UpdateTemplateRequest struct {
 	AccountId     string                  `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 	TemplateId    string                  `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
 	Name          string                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Version       *TemplateVersionContent `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"` // آپدیت کردن یا ایجاد نسخه جدید
+	Version       *TemplateVersionContent `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`             // آپدیت کردن یا ایجاد نسخه جدید
+	UserId        string                  `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // کاربر ویرایش کننده (برای بررسی قفل ویرایش)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -151,6 +152,13 @@ func (x *UpdateTemplateRequest) GetVersion() *TemplateVersionContent {
 	return nil
 }
 
+func (x *UpdateTemplateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type ListTemplatesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
@@ -399,6 +407,66 @@ func (x *TestTemplateRequest) GetTestEmail() string {
 	return ""
 }
 
+type LintTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	VersionId     string                 `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LintTemplateRequest) Reset() {
+	*x = LintTemplateRequest{}
+	mi := &file_camp_v1_template_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LintTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintTemplateRequest) ProtoMessage() {}
+
+func (x *LintTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_camp_v1_template_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintTemplateRequest.ProtoReflect.Descriptor instead.
+func (*LintTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_camp_v1_template_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LintTemplateRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *LintTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *LintTemplateRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
 // ساختار محتوای قالب
 type TemplateVersionContent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -415,7 +483,7 @@ type TemplateVersionContent struct {
 
 func (x *TemplateVersionContent) Reset() {
 	*x = TemplateVersionContent{}
-	mi := &file_camp_v1_template_proto_msgTypes[6]
+	mi := &file_camp_v1_template_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -427,7 +495,7 @@ func (x *TemplateVersionContent) String() string {
 func (*TemplateVersionContent) ProtoMessage() {}
 
 func (x *TemplateVersionContent) ProtoReflect() protoreflect.Message {
-	mi := &file_camp_v1_template_proto_msgTypes[6]
+	mi := &file_camp_v1_template_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -440,7 +508,7 @@ func (x *TemplateVersionContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateVersionContent.ProtoReflect.Descriptor instead.
 func (*TemplateVersionContent) Descriptor() ([]byte, []int) {
-	return file_camp_v1_template_proto_rawDescGZIP(), []int{6}
+	return file_camp_v1_template_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TemplateVersionContent) GetSubject() string {
@@ -506,7 +574,7 @@ type TemplateResponse struct {
 
 func (x *TemplateResponse) Reset() {
 	*x = TemplateResponse{}
-	mi := &file_camp_v1_template_proto_msgTypes[7]
+	mi := &file_camp_v1_template_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -518,7 +586,7 @@ func (x *TemplateResponse) String() string {
 func (*TemplateResponse) ProtoMessage() {}
 
 func (x *TemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_camp_v1_template_proto_msgTypes[7]
+	mi := &file_camp_v1_template_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,7 +599,7 @@ func (x *TemplateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateResponse.ProtoReflect.Descriptor instead.
 func (*TemplateResponse) Descriptor() ([]byte, []int) {
-	return file_camp_v1_template_proto_rawDescGZIP(), []int{7}
+	return file_camp_v1_template_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TemplateResponse) GetId() string {
@@ -579,7 +647,7 @@ type ListTemplatesResponse struct {
 
 func (x *ListTemplatesResponse) Reset() {
 	*x = ListTemplatesResponse{}
-	mi := &file_camp_v1_template_proto_msgTypes[8]
+	mi := &file_camp_v1_template_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -591,7 +659,7 @@ func (x *ListTemplatesResponse) String() string {
 func (*ListTemplatesResponse) ProtoMessage() {}
 
 func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_camp_v1_template_proto_msgTypes[8]
+	mi := &file_camp_v1_template_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,7 +672,7 @@ func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
 func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
-	return file_camp_v1_template_proto_rawDescGZIP(), []int{8}
+	return file_camp_v1_template_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListTemplatesResponse) GetTemplates() []*TemplateResponse {
@@ -631,7 +699,7 @@ type TestTemplateResponse struct {
 
 func (x *TestTemplateResponse) Reset() {
 	*x = TestTemplateResponse{}
-	mi := &file_camp_v1_template_proto_msgTypes[9]
+	mi := &file_camp_v1_template_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -643,7 +711,7 @@ func (x *TestTemplateResponse) String() string {
 func (*TestTemplateResponse) ProtoMessage() {}
 
 func (x *TestTemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_camp_v1_template_proto_msgTypes[9]
+	mi := &file_camp_v1_template_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -656,7 +724,7 @@ func (x *TestTemplateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestTemplateResponse.ProtoReflect.Descriptor instead.
 func (*TestTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_camp_v1_template_proto_rawDescGZIP(), []int{9}
+	return file_camp_v1_template_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TestTemplateResponse) GetSuccess() bool {
@@ -673,6 +741,158 @@ func (x *TestTemplateResponse) GetMessage() string {
 	return ""
 }
 
+type TemplateLintFinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Severity      string                 `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"` // error یا warning
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateLintFinding) Reset() {
+	*x = TemplateLintFinding{}
+	mi := &file_camp_v1_template_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateLintFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateLintFinding) ProtoMessage() {}
+
+func (x *TemplateLintFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_camp_v1_template_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateLintFinding.ProtoReflect.Descriptor instead.
+func (*TemplateLintFinding) Descriptor() ([]byte, []int) {
+	return file_camp_v1_template_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TemplateLintFinding) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *TemplateLintFinding) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *TemplateLintFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TemplateLintFinding) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type TemplateLintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VersionId     string                 `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Passed        bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	ErrorCount    int32                  `protobuf:"varint,3,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	WarningCount  int32                  `protobuf:"varint,4,opt,name=warning_count,json=warningCount,proto3" json:"warning_count,omitempty"`
+	Findings      []*TemplateLintFinding `protobuf:"bytes,5,rep,name=findings,proto3" json:"findings,omitempty"`
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateLintResponse) Reset() {
+	*x = TemplateLintResponse{}
+	mi := &file_camp_v1_template_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateLintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateLintResponse) ProtoMessage() {}
+
+func (x *TemplateLintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_camp_v1_template_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateLintResponse.ProtoReflect.Descriptor instead.
+func (*TemplateLintResponse) Descriptor() ([]byte, []int) {
+	return file_camp_v1_template_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TemplateLintResponse) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *TemplateLintResponse) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *TemplateLintResponse) GetErrorCount() int32 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+func (x *TemplateLintResponse) GetWarningCount() int32 {
+	if x != nil {
+		return x.WarningCount
+	}
+	return 0
+}
+
+func (x *TemplateLintResponse) GetFindings() []*TemplateLintFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *TemplateLintResponse) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
 var File_camp_v1_template_proto protoreflect.FileDescriptor
 
 const file_camp_v1_template_proto_rawDesc = "" +
@@ -682,14 +902,15 @@ const file_camp_v1_template_proto_rawDesc = "" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12L\n" +
-	"\x0finitial_version\x18\x03 \x01(\v2#.campaign.v1.TemplateVersionContentR\x0einitialVersion\"\xaa\x01\n" +
+	"\x0finitial_version\x18\x03 \x01(\v2#.campaign.v1.TemplateVersionContentR\x0einitialVersion\"\xc3\x01\n" +
 	"\x15UpdateTemplateRequest\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1f\n" +
 	"\vtemplate_id\x18\x02 \x01(\tR\n" +
 	"templateId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12=\n" +
-	"\aversion\x18\x04 \x01(\v2#.campaign.v1.TemplateVersionContentR\aversion\"c\n" +
+	"\aversion\x18\x04 \x01(\v2#.campaign.v1.TemplateVersionContentR\aversion\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\"c\n" +
 	"\x14ListTemplatesRequest\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12\x14\n" +
@@ -713,7 +934,14 @@ const file_camp_v1_template_proto_rawDesc = "" +
 	"\n" +
 	"version_id\x18\x03 \x01(\tR\tversionId\x12\x1d\n" +
 	"\n" +
-	"test_email\x18\x04 \x01(\tR\ttestEmail\"\xe5\x01\n" +
+	"test_email\x18\x04 \x01(\tR\ttestEmail\"t\n" +
+	"\x13LintTemplateRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\"\xe5\x01\n" +
 	"\x16TemplateVersionContent\x12\x18\n" +
 	"\asubject\x18\x01 \x01(\tR\asubject\x12!\n" +
 	"\fhtml_content\x18\x02 \x01(\tR\vhtmlContent\x12\x1d\n" +
@@ -735,14 +963,30 @@ const file_camp_v1_template_proto_rawDesc = "" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"J\n" +
 	"\x14TestTemplateResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\x9e\x04\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"{\n" +
+	"\x13TemplateLintFinding\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\tR\bseverity\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\"\x8c\x02\n" +
+	"\x14TemplateLintResponse\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x01 \x01(\tR\tversionId\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12\x1f\n" +
+	"\verror_count\x18\x03 \x01(\x05R\n" +
+	"errorCount\x12#\n" +
+	"\rwarning_count\x18\x04 \x01(\x05R\fwarningCount\x12<\n" +
+	"\bfindings\x18\x05 \x03(\v2 .campaign.v1.TemplateLintFindingR\bfindings\x129\n" +
+	"\n" +
+	"checked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt2\xf3\x04\n" +
 	"\x11ITemplateServices\x12S\n" +
 	"\x0eCreateTemplate\x12\".campaign.v1.CreateTemplateRequest\x1a\x1d.campaign.v1.TemplateResponse\x12S\n" +
 	"\x0eUpdateTemplate\x12\".campaign.v1.UpdateTemplateRequest\x1a\x1d.campaign.v1.TemplateResponse\x12V\n" +
 	"\rListTemplates\x12!.campaign.v1.ListTemplatesRequest\x1a\".campaign.v1.ListTemplatesResponse\x12O\n" +
 	"\fCopyTemplate\x12 .campaign.v1.CopyTemplateRequest\x1a\x1d.campaign.v1.TemplateResponse\x12a\n" +
 	"\x15ImportTemplateFromUrl\x12).campaign.v1.ImportTemplateFromUrlRequest\x1a\x1d.campaign.v1.TemplateResponse\x12S\n" +
-	"\fTestTemplate\x12 .campaign.v1.TestTemplateRequest\x1a!.campaign.v1.TestTemplateResponseB;Z9github.com/ehsanshah/empire-protos/campaign/v1;campaignv1b\x06proto3"
+	"\fTestTemplate\x12 .campaign.v1.TestTemplateRequest\x1a!.campaign.v1.TestTemplateResponse\x12S\n" +
+	"\fLintTemplate\x12 .campaign.v1.LintTemplateRequest\x1a!.campaign.v1.TemplateLintResponseB;Z9github.com/ehsanshah/empire-protos/campaign/v1;campaignv1b\x06proto3"
 
 var (
 	file_camp_v1_template_proto_rawDescOnce sync.Once
@@ -756,7 +1000,7 @@ func file_camp_v1_template_proto_rawDescGZIP() []byte {
 	return file_camp_v1_template_proto_rawDescData
 }
 
-var file_camp_v1_template_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_camp_v1_template_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_camp_v1_template_proto_goTypes = []any{
 	(*CreateTemplateRequest)(nil),        // 0: campaign.v1.CreateTemplateRequest
 	(*UpdateTemplateRequest)(nil),        // 1: campaign.v1.UpdateTemplateRequest
@@ -764,35 +1008,42 @@ var file_camp_v1_template_proto_goTypes = []any{
 	(*CopyTemplateRequest)(nil),          // 3: campaign.v1.CopyTemplateRequest
 	(*ImportTemplateFromUrlRequest)(nil), // 4: campaign.v1.ImportTemplateFromUrlRequest
 	(*TestTemplateRequest)(nil),          // 5: campaign.v1.TestTemplateRequest
-	(*TemplateVersionContent)(nil),       // 6: campaign.v1.TemplateVersionContent
-	(*TemplateResponse)(nil),             // 7: campaign.v1.TemplateResponse
-	(*ListTemplatesResponse)(nil),        // 8: campaign.v1.ListTemplatesResponse
-	(*TestTemplateResponse)(nil),         // 9: campaign.v1.TestTemplateResponse
-	(*timestamppb.Timestamp)(nil),        // 10: google.protobuf.Timestamp
+	(*LintTemplateRequest)(nil),          // 6: campaign.v1.LintTemplateRequest
+	(*TemplateVersionContent)(nil),       // 7: campaign.v1.TemplateVersionContent
+	(*TemplateResponse)(nil),             // 8: campaign.v1.TemplateResponse
+	(*ListTemplatesResponse)(nil),        // 9: campaign.v1.ListTemplatesResponse
+	(*TestTemplateResponse)(nil),         // 10: campaign.v1.TestTemplateResponse
+	(*TemplateLintFinding)(nil),          // 11: campaign.v1.TemplateLintFinding
+	(*TemplateLintResponse)(nil),         // 12: campaign.v1.TemplateLintResponse
+	(*timestamppb.Timestamp)(nil),        // 13: google.protobuf.Timestamp
 }
 var file_camp_v1_template_proto_depIdxs = []int32{
-	6,  // 0: campaign.v1.CreateTemplateRequest.initial_version:type_name -> campaign.v1.TemplateVersionContent
-	6,  // 1: campaign.v1.UpdateTemplateRequest.version:type_name -> campaign.v1.TemplateVersionContent
-	6,  // 2: campaign.v1.TemplateResponse.latest_version:type_name -> campaign.v1.TemplateVersionContent
-	10, // 3: campaign.v1.TemplateResponse.created_at:type_name -> google.protobuf.Timestamp
-	7,  // 4: campaign.v1.ListTemplatesResponse.templates:type_name -> campaign.v1.TemplateResponse
-	0,  // 5: campaign.v1.ITemplateServices.CreateTemplate:input_type -> campaign.v1.CreateTemplateRequest
-	1,  // 6: campaign.v1.ITemplateServices.UpdateTemplate:input_type -> campaign.v1.UpdateTemplateRequest
-	2,  // 7: campaign.v1.ITemplateServices.ListTemplates:input_type -> campaign.v1.ListTemplatesRequest
-	3,  // 8: campaign.v1.ITemplateServices.CopyTemplate:input_type -> campaign.v1.CopyTemplateRequest
-	4,  // 9: campaign.v1.ITemplateServices.ImportTemplateFromUrl:input_type -> campaign.v1.ImportTemplateFromUrlRequest
-	5,  // 10: campaign.v1.ITemplateServices.TestTemplate:input_type -> campaign.v1.TestTemplateRequest
-	7,  // 11: campaign.v1.ITemplateServices.CreateTemplate:output_type -> campaign.v1.TemplateResponse
-	7,  // 12: campaign.v1.ITemplateServices.UpdateTemplate:output_type -> campaign.v1.TemplateResponse
-	8,  // 13: campaign.v1.ITemplateServices.ListTemplates:output_type -> campaign.v1.ListTemplatesResponse
-	7,  // 14: campaign.v1.ITemplateServices.CopyTemplate:output_type -> campaign.v1.TemplateResponse
-	7,  // 15: campaign.v1.ITemplateServices.ImportTemplateFromUrl:output_type -> campaign.v1.TemplateResponse
-	9,  // 16: campaign.v1.ITemplateServices.TestTemplate:output_type -> campaign.v1.TestTemplateResponse
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	7,  // 0: campaign.v1.CreateTemplateRequest.initial_version:type_name -> campaign.v1.TemplateVersionContent
+	7,  // 1: campaign.v1.UpdateTemplateRequest.version:type_name -> campaign.v1.TemplateVersionContent
+	7,  // 2: campaign.v1.TemplateResponse.latest_version:type_name -> campaign.v1.TemplateVersionContent
+	13, // 3: campaign.v1.TemplateResponse.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 4: campaign.v1.ListTemplatesResponse.templates:type_name -> campaign.v1.TemplateResponse
+	11, // 5: campaign.v1.TemplateLintResponse.findings:type_name -> campaign.v1.TemplateLintFinding
+	13, // 6: campaign.v1.TemplateLintResponse.checked_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: campaign.v1.ITemplateServices.CreateTemplate:input_type -> campaign.v1.CreateTemplateRequest
+	1,  // 8: campaign.v1.ITemplateServices.UpdateTemplate:input_type -> campaign.v1.UpdateTemplateRequest
+	2,  // 9: campaign.v1.ITemplateServices.ListTemplates:input_type -> campaign.v1.ListTemplatesRequest
+	3,  // 10: campaign.v1.ITemplateServices.CopyTemplate:input_type -> campaign.v1.CopyTemplateRequest
+	4,  // 11: campaign.v1.ITemplateServices.ImportTemplateFromUrl:input_type -> campaign.v1.ImportTemplateFromUrlRequest
+	5,  // 12: campaign.v1.ITemplateServices.TestTemplate:input_type -> campaign.v1.TestTemplateRequest
+	6,  // 13: campaign.v1.ITemplateServices.LintTemplate:input_type -> campaign.v1.LintTemplateRequest
+	8,  // 14: campaign.v1.ITemplateServices.CreateTemplate:output_type -> campaign.v1.TemplateResponse
+	8,  // 15: campaign.v1.ITemplateServices.UpdateTemplate:output_type -> campaign.v1.TemplateResponse
+	9,  // 16: campaign.v1.ITemplateServices.ListTemplates:output_type -> campaign.v1.ListTemplatesResponse
+	8,  // 17: campaign.v1.ITemplateServices.CopyTemplate:output_type -> campaign.v1.TemplateResponse
+	8,  // 18: campaign.v1.ITemplateServices.ImportTemplateFromUrl:output_type -> campaign.v1.TemplateResponse
+	10, // 19: campaign.v1.ITemplateServices.TestTemplate:output_type -> campaign.v1.TestTemplateResponse
+	12, // 20: campaign.v1.ITemplateServices.LintTemplate:output_type -> campaign.v1.TemplateLintResponse
+	14, // [14:21] is the sub-list for method output_type
+	7,  // [7:14] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_camp_v1_template_proto_init() }
@@ -806,7 +1057,7 @@ func file_camp_v1_template_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_camp_v1_template_proto_rawDesc), len(file_camp_v1_template_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,7 @@ const (
 	ITemplateServices_CopyTemplate_FullMethodName          = "/campaign.v1.ITemplateServices/CopyTemplate"
 	ITemplateServices_ImportTemplateFromUrl_FullMethodName = "/campaign.v1.ITemplateServices/ImportTemplateFromUrl"
 	ITemplateServices_TestTemplate_FullMethodName          = "/campaign.v1.ITemplateServices/TestTemplate"
+	ITemplateServices_LintTemplate_FullMethodName          = "/campaign.v1.ITemplateServices/LintTemplate"
 )
 
 // ITemplateServicesClient is the client API for ITemplateServices service.
@@ -37,6 +38,8 @@ type ITemplateServicesClient interface {
 	CopyTemplate(ctx context.Context, in *CopyTemplateRequest, opts ...grpc.CallOption) (*TemplateResponse, error)
 	ImportTemplateFromUrl(ctx context.Context, in *ImportTemplateFromUrlRequest, opts ...grpc.CallOption) (*TemplateResponse, error)
 	TestTemplate(ctx context.Context, in *TestTemplateRequest, opts ...grpc.CallOption) (*TestTemplateResponse, error)
+	// بررسی کیفیت نسخه (لینک لغو اشتراک، alt تصاویر، لینک‌های خراب و ...)؛ version_id خالی یعنی نسخه فعلی
+	LintTemplate(ctx context.Context, in *LintTemplateRequest, opts ...grpc.CallOption) (*TemplateLintResponse, error)
 }
 
 type iTemplateServicesClient struct {
@@ -107,6 +110,16 @@ func (c *iTemplateServicesClient) TestTemplate(ctx context.Context, in *TestTemp
 	return out, nil
 }
 
+func (c *iTemplateServicesClient) LintTemplate(ctx context.Context, in *LintTemplateRequest, opts ...grpc.CallOption) (*TemplateLintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplateLintResponse)
+	err := c.cc.Invoke(ctx, ITemplateServices_LintTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ITemplateServicesServer is the server API for ITemplateServices service.
 // All implementations must embed UnimplementedITemplateServicesServer
 // for forward compatibility.
@@ -117,6 +130,8 @@ type ITemplateServicesServer interface {
 	CopyTemplate(context.Context, *CopyTemplateRequest) (*TemplateResponse, error)
 	ImportTemplateFromUrl(context.Context, *ImportTemplateFromUrlRequest) (*TemplateResponse, error)
 	TestTemplate(context.Context, *TestTemplateRequest) (*TestTemplateResponse, error)
+	// بررسی کیفیت نسخه (لینک لغو اشتراک، alt تصاویر، لینک‌های خراب و ...)؛ version_id خالی یعنی نسخه فعلی
+	LintTemplate(context.Context, *LintTemplateRequest) (*TemplateLintResponse, error)
 	mustEmbedUnimplementedITemplateServicesServer()
 }
 
@@ -145,6 +160,9 @@ func (UnimplementedITemplateServicesServer) ImportTemplateFromUrl(context.Contex
 func (UnimplementedITemplateServicesServer) TestTemplate(context.Context, *TestTemplateRequest) (*TestTemplateResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method TestTemplate not implemented")
 }
+func (UnimplementedITemplateServicesServer) LintTemplate(context.Context, *LintTemplateRequest) (*TemplateLintResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LintTemplate not implemented")
+}
 func (UnimplementedITemplateServicesServer) mustEmbedUnimplementedITemplateServicesServer() {}
 func (UnimplementedITemplateServicesServer) testEmbeddedByValue()                           {}
 
@@ -274,6 +292,24 @@ func _ITemplateServices_TestTemplate_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ITemplateServices_LintTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LintTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ITemplateServicesServer).LintTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ITemplateServices_LintTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ITemplateServicesServer).LintTemplate(ctx, req.(*LintTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ITemplateServices_ServiceDesc is the grpc.ServiceDesc for ITemplateServices service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +341,10 @@ var ITemplateServices_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TestTemplate",
 			Handler:    _ITemplateServices_TestTemplate_Handler,
 		},
+		{
+			MethodName: "LintTemplate",
+			Handler:    _ITemplateServices_LintTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "camp/v1/template.proto",